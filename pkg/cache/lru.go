@@ -0,0 +1,177 @@
+package cache
+
+import (
+	"github.com/barnowlsnest/go-datalib/pkg/node"
+)
+
+type (
+	// lruEntry holds the key and value stored behind a recency node.
+	lruEntry[K comparable, V any] struct {
+		key   K
+		value V
+	}
+
+	// LRU implements a fixed-capacity, least-recently-used cache.
+	//
+	// Entries are kept in a doubly-linked chain of node.Node values ordered by
+	// recency: the head is the most recently used entry and the tail the least
+	// recently used one. A map from key to node gives O(1) lookups, and a map
+	// from node ID to entry holds the cached data, so the chain itself only
+	// carries IDs.
+	//
+	// Key features:
+	//   - O(1) Get and Put
+	//   - Get and Put promote the entry to most recently used
+	//   - Put evicts the least recently used entry once capacity is exceeded
+	//
+	// Thread Safety:
+	// LRU is not thread-safe. Concurrent access requires external
+	// synchronization mechanisms.
+	LRU[K comparable, V any] struct {
+		// capacity is the maximum number of entries held at once.
+		capacity int
+
+		// nodes maps each cached key to its node in the recency chain.
+		nodes map[K]*node.Node
+
+		// entries maps node IDs to the key-value pairs they represent.
+		entries map[uint64]lruEntry[K, V]
+
+		// head is the most recently used node, or nil if the cache is empty.
+		head *node.Node
+
+		// tail is the least recently used node, or nil if the cache is empty.
+		tail *node.Node
+
+		// nextID is the ID assigned to the next inserted node.
+		nextID uint64
+	}
+)
+
+// NewLRU creates a new empty LRU cache holding at most capacity entries.
+// A capacity below 1 is treated as 1.
+//
+// Parameters:
+//   - capacity: The maximum number of entries
+//
+// Returns:
+//   - A new empty LRU instance ready for use
+//
+// Example:
+//
+//	c := NewLRU[string, int](2)
+//	c.Put("a", 1)
+//	c.Put("b", 2)
+//	c.Get("a")    // "a" is now most recently used
+//	c.Put("c", 3) // evicts "b"
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	capacity = max(capacity, 1)
+	return &LRU[K, V]{
+		capacity: capacity,
+		nodes:    make(map[K]*node.Node, capacity),
+		entries:  make(map[uint64]lruEntry[K, V], capacity),
+	}
+}
+
+// Get returns the value cached under key and promotes it to most recently used.
+//
+// Parameters:
+//   - key: The key to look up
+//
+// Returns:
+//   - The cached value and true if present, the zero value and false otherwise
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	n, ok := c.nodes[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	c.moveToFront(n)
+	return c.entries[n.ID()].value, true
+}
+
+// Put caches value under key and marks it as most recently used. If the key is
+// already present its value is replaced; otherwise, when the cache is full, the
+// least recently used entry is evicted to make room.
+//
+// Parameters:
+//   - key: The key to store
+//   - value: The value to associate with key
+func (c *LRU[K, V]) Put(key K, value V) {
+	if n, ok := c.nodes[key]; ok {
+		c.entries[n.ID()] = lruEntry[K, V]{key: key, value: value}
+		c.moveToFront(n)
+		return
+	}
+
+	c.nextID++
+	n := node.ID(c.nextID)
+	c.nodes[key] = n
+	c.entries[n.ID()] = lruEntry[K, V]{key: key, value: value}
+	c.pushFront(n)
+
+	if len(c.nodes) > c.capacity {
+		c.evict()
+	}
+}
+
+// Len returns the number of entries currently cached.
+func (c *LRU[K, V]) Len() int {
+	return len(c.nodes)
+}
+
+// Cap returns the maximum number of entries the cache holds.
+func (c *LRU[K, V]) Cap() int {
+	return c.capacity
+}
+
+// evict removes the least recently used entry.
+func (c *LRU[K, V]) evict() {
+	n := c.tail
+	c.unlink(n)
+
+	e := c.entries[n.ID()]
+	delete(c.entries, n.ID())
+	delete(c.nodes, e.key)
+}
+
+// moveToFront promotes n to the head of the recency chain.
+func (c *LRU[K, V]) moveToFront(n *node.Node) {
+	if n == c.head {
+		return
+	}
+
+	c.unlink(n)
+	c.pushFront(n)
+}
+
+// pushFront links the detached node n at the head of the recency chain.
+func (c *LRU[K, V]) pushFront(n *node.Node) {
+	n.WithPrev(nil)
+	n.WithNext(c.head)
+	if c.head != nil {
+		c.head.WithPrev(n)
+	} else {
+		c.tail = n
+	}
+	c.head = n
+}
+
+// unlink removes n from the recency chain and clears its links.
+func (c *LRU[K, V]) unlink(n *node.Node) {
+	if prev := n.Prev(); prev != nil {
+		prev.WithNext(n.Next())
+	} else {
+		c.head = n.Next()
+	}
+
+	if next := n.Next(); next != nil {
+		next.WithPrev(n.Prev())
+	} else {
+		c.tail = n.Prev()
+	}
+
+	n.WithNext(nil)
+	n.WithPrev(nil)
+}
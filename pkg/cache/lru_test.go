@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// LRUTestSuite tests LRU cache operations
+type LRUTestSuite struct {
+	suite.Suite
+}
+
+// recency returns the cached keys from most to least recently used.
+func (s *LRUTestSuite) recency(c *LRU[string, int]) []string {
+	var keys []string
+	for n := c.head; n != nil; n = n.Next() {
+		keys = append(keys, c.entries[n.ID()].key)
+	}
+
+	var reversed []string
+	for n := c.tail; n != nil; n = n.Prev() {
+		reversed = append([]string{c.entries[n.ID()].key}, reversed...)
+	}
+	s.Require().Equal(keys, reversed, "forward and backward links disagree")
+
+	return keys
+}
+
+func (s *LRUTestSuite) TestNewLRU() {
+	c := NewLRU[string, int](3)
+	s.Require().Equal(0, c.Len())
+	s.Require().Equal(3, c.Cap())
+
+	_, ok := c.Get("missing")
+	s.Require().False(ok)
+
+	s.Require().Equal(1, NewLRU[string, int](0).Cap())
+	s.Require().Equal(1, NewLRU[string, int](-5).Cap())
+}
+
+func (s *LRUTestSuite) TestPutAndGet() {
+	c := NewLRU[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	v, ok := c.Get("a")
+	s.Require().True(ok)
+	s.Require().Equal(1, v)
+
+	c.Put("a", 10)
+	v, ok = c.Get("a")
+	s.Require().True(ok)
+	s.Require().Equal(10, v, "Put on an existing key replaces the value")
+	s.Require().Equal(2, c.Len())
+}
+
+func (s *LRUTestSuite) TestEvictionOrder() {
+	c := NewLRU[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	s.Require().Equal([]string{"c", "b", "a"}, s.recency(c))
+
+	// Get promotes "a", so "b" becomes the least recently used entry.
+	_, ok := c.Get("a")
+	s.Require().True(ok)
+	s.Require().Equal([]string{"a", "c", "b"}, s.recency(c))
+
+	c.Put("d", 4)
+	s.Require().Equal(3, c.Len())
+	_, ok = c.Get("b")
+	s.Require().False(ok, "b should have been evicted")
+	s.Require().Equal([]string{"d", "a", "c"}, s.recency(c))
+
+	// Updating "c" promotes it, so "a" goes next.
+	c.Put("c", 30)
+	c.Put("e", 5)
+	_, ok = c.Get("a")
+	s.Require().False(ok, "a should have been evicted")
+	s.Require().Equal([]string{"e", "c", "d"}, s.recency(c))
+	s.Require().Len(c.entries, 3)
+}
+
+func (s *LRUTestSuite) TestCapacityOne() {
+	c := NewLRU[string, int](1)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	_, ok := c.Get("a")
+	s.Require().False(ok)
+	v, ok := c.Get("b")
+	s.Require().True(ok)
+	s.Require().Equal(2, v)
+	s.Require().Equal([]string{"b"}, s.recency(c))
+}
+
+func (s *LRUTestSuite) TestManyInsertions() {
+	const capacity = 100
+	c := NewLRU[string, int](capacity)
+
+	for i := 0; i < 10*capacity; i++ {
+		c.Put(strconv.Itoa(i), i)
+		s.Require().LessOrEqual(c.Len(), capacity)
+	}
+
+	s.Require().Equal(capacity, c.Len())
+	s.Require().Len(c.entries, capacity)
+	s.Require().Len(s.recency(c), capacity)
+}
+
+func TestLRUTestSuite(t *testing.T) {
+	suite.Run(t, new(LRUTestSuite))
+}
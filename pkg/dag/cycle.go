@@ -0,0 +1,266 @@
+package dag
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/barnowlsnest/go-datalib/pkg/list"
+	"github.com/barnowlsnest/go-datalib/pkg/node"
+)
+
+const (
+	unvisited = iota // not visited yet
+	onPath           // on the current DFS path
+	explored         // fully explored
+)
+
+// FindCycle locates one concrete cycle in the graph using an iterative,
+// colour-marking depth-first search.
+//
+// The cycle is returned as an ordered slice of nodes where each node has an edge
+// to the next one and the last node has an edge back to the first one (the first
+// node is not repeated at the end). A self-loop yields a single-element slice.
+// Returns nil, nil when the graph is acyclic.
+//
+// Returns ErrNodeNotFound if a node on the cycle doesn't belong to any group.
+//
+// Time complexity: O(V + E) where V is nodes and E is edges
+// Space complexity: O(V)
+func (g *Graph) FindCycle() ([]GroupNode, error) {
+	colour := make(map[NodeID]int, len(g.adjacency))
+	parent := make(map[NodeID]NodeID, len(g.adjacency))
+	cursor := make(map[NodeID]int, len(g.adjacency))
+	neighbours := make(map[NodeID][]NodeID, len(g.adjacency))
+
+	for _, start := range slices.Sorted(maps.Keys(g.adjacency)) {
+		if colour[start] != unvisited {
+			continue
+		}
+
+		s := list.NewStack()
+		s.Push(node.ID(start))
+		colour[start] = onPath
+
+		for !s.IsEmpty() {
+			top, ok := s.Peek()
+			if !ok {
+				break
+			}
+
+			id := top.ID()
+			if _, cached := neighbours[id]; !cached {
+				neighbours[id] = slices.Sorted(maps.Keys(g.adjacency[id]))
+			}
+
+			if cursor[id] == len(neighbours[id]) {
+				colour[id] = explored
+				s.Pop()
+				continue
+			}
+
+			to := neighbours[id][cursor[id]]
+			cursor[id]++
+
+			switch colour[to] {
+			case unvisited:
+				colour[to] = onPath
+				parent[to] = id
+				s.Push(node.ID(to))
+			case onPath:
+				return g.cycleFrom(to, id, parent)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// cycleFrom rebuilds the cycle closed by the back edge last -> first by walking
+// the DFS parent chain from last up to first.
+func (g *Graph) cycleFrom(first, last NodeID, parent map[NodeID]NodeID) ([]GroupNode, error) {
+	ids := []NodeID{last}
+	for id := last; id != first; {
+		id = parent[id]
+		ids = append(ids, id)
+	}
+	slices.Reverse(ids)
+
+	cycle := make([]GroupNode, len(ids))
+	for i, id := range ids {
+		gn, ok := g.resolve(id)
+		if !ok {
+			return nil, errors.Join(ErrNodeNotFound, fmt.Errorf("node [%d]", id))
+		}
+		cycle[i] = gn
+	}
+	return cycle, nil
+}
+
+// nodeIDs returns the IDs of every node in every group, sorted ascending.
+func (g *Graph) nodeIDs() []NodeID {
+	ids := make(map[NodeID]struct{})
+	for _, nodes := range g.groups {
+		for id := range nodes {
+			ids[id] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(ids))
+}
+
+// finishOrder appends to order every node reachable from start over outgoing
+// edges, in the order their iterative depth-first exploration finishes.
+func (g *Graph) finishOrder(start NodeID, visited map[NodeID]struct{}, order []NodeID) []NodeID {
+	cursor := make(map[NodeID]int)
+	neighbours := make(map[NodeID][]NodeID)
+
+	s := list.NewStack()
+	s.Push(node.ID(start))
+	visited[start] = struct{}{}
+
+	for !s.IsEmpty() {
+		top, ok := s.Peek()
+		if !ok {
+			break
+		}
+
+		id := top.ID()
+		if _, cached := neighbours[id]; !cached {
+			neighbours[id] = slices.Sorted(maps.Keys(g.adjacency[id]))
+		}
+
+		if cursor[id] == len(neighbours[id]) {
+			order = append(order, id)
+			s.Pop()
+			continue
+		}
+
+		to := neighbours[id][cursor[id]]
+		cursor[id]++
+		if _, seen := visited[to]; !seen {
+			visited[to] = struct{}{}
+			s.Push(node.ID(to))
+		}
+	}
+
+	return order
+}
+
+// StronglyConnectedComponents partitions the graph into strongly connected
+// components using Kosaraju's algorithm: a depth-first pass over outgoing edges
+// records finish order, then a second pass over back-references in reverse
+// finish order collects each component.
+//
+// Every node of every group appears in exactly one component, so nodes that are
+// not part of any cycle are returned as singleton components. A component with
+// more than one node, or a singleton with a self-loop, indicates a cycle.
+//
+// Note: Components are returned in topological order of the condensed graph
+// (a component only has edges to components after it); node order within a
+// component is unspecified.
+//
+// Time complexity: O(V + E) where V is nodes and E is edges
+// Space complexity: O(V)
+func (g *Graph) StronglyConnectedComponents() [][]GroupNode {
+	ids := g.nodeIDs()
+	visited := make(map[NodeID]struct{}, len(ids))
+	order := make([]NodeID, 0, len(ids))
+	for _, id := range ids {
+		if _, seen := visited[id]; !seen {
+			order = g.finishOrder(id, visited, order)
+		}
+	}
+
+	assigned := make(map[NodeID]struct{}, len(ids))
+	components := make([][]GroupNode, 0)
+	for i := len(order) - 1; i >= 0; i-- {
+		root := order[i]
+		if _, done := assigned[root]; done {
+			continue
+		}
+
+		component := make([]GroupNode, 0)
+		s := list.NewStack()
+		s.Push(node.ID(root))
+		assigned[root] = struct{}{}
+		for !s.IsEmpty() {
+			n := s.Pop()
+			if n == nil {
+				break
+			}
+			if gn, ok := g.resolve(n.ID()); ok {
+				component = append(component, gn)
+			}
+			for from := range g.backRefs[n.ID()] {
+				if _, done := assigned[from]; !done {
+					assigned[from] = struct{}{}
+					s.Push(node.ID(from))
+				}
+			}
+		}
+		components = append(components, component)
+	}
+
+	return components
+}
+
+// WeakComponents partitions the graph into weakly connected components: groups
+// of nodes that are connected when edge direction is ignored. Each component is
+// collected with a breadth-first search over both outgoing edges and
+// back-references.
+//
+// Every node of every group appears in exactly one component, so isolated nodes
+// are returned as singleton components. More than one component means the graph
+// has parts that are not connected to each other at all.
+//
+// Note: Nodes within a component are sorted by ID, and components are ordered by
+// their smallest node ID.
+//
+// Time complexity: O(V log V + E) where V is nodes and E is edges
+// Space complexity: O(V)
+func (g *Graph) WeakComponents() [][]GroupNode {
+	ids := g.nodeIDs()
+	visited := make(map[NodeID]struct{}, len(ids))
+	components := make([][]GroupNode, 0)
+	for _, root := range ids {
+		if _, seen := visited[root]; seen {
+			continue
+		}
+
+		members := make([]NodeID, 0)
+		q := list.NewQueue()
+		q.Enqueue(node.ID(root))
+		visited[root] = struct{}{}
+		for !q.IsEmpty() {
+			n := q.Dequeue()
+			if n == nil {
+				break
+			}
+			members = append(members, n.ID())
+			for id := range g.backRefs[n.ID()] {
+				if _, seen := visited[id]; !seen {
+					visited[id] = struct{}{}
+					q.Enqueue(node.ID(id))
+				}
+			}
+			for id := range g.adjacency[n.ID()] {
+				if _, seen := visited[id]; !seen {
+					visited[id] = struct{}{}
+					q.Enqueue(node.ID(id))
+				}
+			}
+		}
+
+		slices.Sort(members)
+		component := make([]GroupNode, 0, len(members))
+		for _, id := range members {
+			if gn, ok := g.resolve(id); ok {
+				component = append(component, gn)
+			}
+		}
+		components = append(components, component)
+	}
+
+	return components
+}
@@ -0,0 +1,277 @@
+package dag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// FindCycleTestSuite tests concrete cycle reporting
+type FindCycleTestSuite struct {
+	suite.Suite
+}
+
+// requireCycle asserts that cycle is a closed walk over existing edges.
+func (s *FindCycleTestSuite) requireCycle(ag *Graph, cycle []GroupNode) {
+	s.Require().NotEmpty(cycle)
+	for i, from := range cycle {
+		to := cycle[(i+1)%len(cycle)]
+		s.Require().True(ag.HasEdge(from, to), "missing edge %d -> %d", from.ID, to.ID)
+	}
+}
+
+func (s *FindCycleTestSuite) TestFindCycle_EmptyGraph() {
+	ag := New()
+
+	cycle, err := ag.FindCycle()
+	s.Require().NoError(err)
+	s.Require().Nil(cycle)
+}
+
+func (s *FindCycleTestSuite) TestFindCycle_Acyclic() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "test"}
+	node2 := GroupNode{ID: 2, Group: "test"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	_ = ag.AddNode(node1)
+	_ = ag.AddNode(node2)
+	_ = ag.AddNode(node3)
+	_ = ag.AddEdge(node1, node2)
+	_ = ag.AddEdge(node1, node3)
+	_ = ag.AddEdge(node2, node3)
+
+	cycle, err := ag.FindCycle()
+	s.Require().NoError(err)
+	s.Require().Nil(cycle)
+}
+
+func (s *FindCycleTestSuite) TestFindCycle_SelfLoop() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	node := GroupNode{ID: 1, Group: "test"}
+	_ = ag.AddNode(node)
+	_ = ag.AddEdge(node, node)
+
+	cycle, err := ag.FindCycle()
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{node}, cycle)
+}
+
+func (s *FindCycleTestSuite) TestFindCycle_AcrossGroups() {
+	ag := New()
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "build"}
+	node2 := GroupNode{ID: 2, Group: "build"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	node4 := GroupNode{ID: 4, Group: "test"}
+	_ = ag.AddNode(node1)
+	_ = ag.AddNode(node2)
+	_ = ag.AddNode(node3)
+	_ = ag.AddNode(node4)
+
+	// 1 -> 2 -> 3 -> 4 -> 2
+	_ = ag.AddEdge(node1, node2)
+	_ = ag.AddEdge(node2, node3)
+	_ = ag.AddEdge(node3, node4)
+	_ = ag.AddEdge(node4, node2)
+
+	cycle, err := ag.FindCycle()
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{node2, node3, node4}, cycle)
+	s.requireCycle(ag, cycle)
+}
+
+func (s *FindCycleTestSuite) TestFindCycle_DisconnectedWithCycle() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	nodes := make([]GroupNode, 6)
+	for i := range nodes {
+		nodes[i] = GroupNode{ID: NodeID(i + 1), Group: "test"}
+		_ = ag.AddNode(nodes[i])
+	}
+
+	// acyclic component: 1 -> 2 -> 3
+	_ = ag.AddEdge(nodes[0], nodes[1])
+	_ = ag.AddEdge(nodes[1], nodes[2])
+	// cyclic component: 4 -> 5 -> 6 -> 4
+	_ = ag.AddEdge(nodes[3], nodes[4])
+	_ = ag.AddEdge(nodes[4], nodes[5])
+	_ = ag.AddEdge(nodes[5], nodes[3])
+
+	cycle, err := ag.FindCycle()
+	s.Require().NoError(err)
+	s.Require().Len(cycle, 3)
+	s.Require().ElementsMatch([]GroupNode{nodes[3], nodes[4], nodes[5]}, cycle)
+	s.requireCycle(ag, cycle)
+}
+
+func (s *FindCycleTestSuite) TestFindCycle_AgreesWithIsAcyclic() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	nodes := make([]GroupNode, 50)
+	for i := range nodes {
+		nodes[i] = GroupNode{ID: NodeID(i + 1), Group: "test"}
+		_ = ag.AddNode(nodes[i])
+	}
+	for i := 0; i < len(nodes)-1; i++ {
+		_ = ag.AddEdge(nodes[i], nodes[i+1])
+	}
+
+	cycle, err := ag.FindCycle()
+	s.Require().NoError(err)
+	s.Require().Nil(cycle)
+	s.Require().True(<-ag.IsAcyclic())
+
+	_ = ag.AddEdge(nodes[len(nodes)-1], nodes[10])
+
+	cycle, err = ag.FindCycle()
+	s.Require().NoError(err)
+	s.Require().Len(cycle, len(nodes)-10)
+	s.requireCycle(ag, cycle)
+	s.Require().False(<-ag.IsAcyclic())
+}
+
+// SCCTestSuite tests strongly connected component detection
+type SCCTestSuite struct {
+	suite.Suite
+}
+
+func (s *SCCTestSuite) TestSCC_EmptyGraph() {
+	ag := New()
+	s.Require().Empty(ag.StronglyConnectedComponents())
+}
+
+func (s *SCCTestSuite) TestSCC_AcyclicYieldsSingletons() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	nodes := make([]GroupNode, 4)
+	for i := range nodes {
+		nodes[i] = GroupNode{ID: NodeID(i + 1), Group: "test"}
+		_ = ag.AddNode(nodes[i])
+	}
+	_ = ag.AddEdge(nodes[0], nodes[1])
+	_ = ag.AddEdge(nodes[1], nodes[2])
+
+	components := ag.StronglyConnectedComponents()
+	s.Require().Len(components, 4)
+	for _, c := range components {
+		s.Require().Len(c, 1)
+	}
+}
+
+func (s *SCCTestSuite) TestSCC_MixedComponents() {
+	ag := New()
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("test")
+
+	nodes := make([]GroupNode, 8)
+	for i := range nodes {
+		group := "build"
+		if i >= 4 {
+			group = "test"
+		}
+		nodes[i] = GroupNode{ID: NodeID(i + 1), Group: group}
+		_ = ag.AddNode(nodes[i])
+	}
+
+	// component A: 1 <-> 2 <-> 3 (cycle 1 -> 2 -> 3 -> 1)
+	_ = ag.AddEdge(nodes[0], nodes[1])
+	_ = ag.AddEdge(nodes[1], nodes[2])
+	_ = ag.AddEdge(nodes[2], nodes[0])
+	// A -> B
+	_ = ag.AddEdge(nodes[2], nodes[3])
+	// component B: 4 <-> 5 across groups
+	_ = ag.AddEdge(nodes[3], nodes[4])
+	_ = ag.AddEdge(nodes[4], nodes[3])
+	// B -> 6, 6 has a self-loop
+	_ = ag.AddEdge(nodes[4], nodes[5])
+	_ = ag.AddEdge(nodes[5], nodes[5])
+	// 7 -> 8 acyclic tail
+	_ = ag.AddEdge(nodes[6], nodes[7])
+
+	components := ag.StronglyConnectedComponents()
+	s.Require().Len(components, 5)
+
+	index := make(map[NodeID]int)
+	total := 0
+	for i, c := range components {
+		total += len(c)
+		for _, gn := range c {
+			index[gn.ID] = i
+		}
+	}
+	s.Require().Equal(len(nodes), total)
+
+	s.Require().ElementsMatch([]GroupNode{nodes[0], nodes[1], nodes[2]}, components[index[1]])
+	s.Require().ElementsMatch([]GroupNode{nodes[3], nodes[4]}, components[index[4]])
+	s.Require().Equal([]GroupNode{nodes[5]}, components[index[6]])
+	s.Require().Equal([]GroupNode{nodes[6]}, components[index[7]])
+	s.Require().Equal([]GroupNode{nodes[7]}, components[index[8]])
+
+	// topological order of the condensation
+	s.Require().Less(index[1], index[4])
+	s.Require().Less(index[4], index[6])
+	s.Require().Less(index[7], index[8])
+}
+
+func (s *SCCTestSuite) TestWeakComponents_Empty() {
+	ag := New()
+	s.Require().Empty(ag.WeakComponents())
+}
+
+func (s *SCCTestSuite) TestWeakComponents() {
+	ag := New()
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("test")
+
+	nodes := make([]GroupNode, 9)
+	for i := range nodes {
+		group := "build"
+		if i >= 4 {
+			group = "test"
+		}
+		nodes[i] = GroupNode{ID: NodeID(i + 1), Group: group}
+		_ = ag.AddNode(nodes[i])
+	}
+
+	// 1 -> 2 <- 3: connected only when direction is ignored
+	_ = ag.AddEdge(nodes[0], nodes[1])
+	_ = ag.AddEdge(nodes[2], nodes[1])
+	// 4 -> 5 across groups, 7 -> 5 joins from further away, 5 -> 5 self-loop
+	_ = ag.AddEdge(nodes[3], nodes[4])
+	_ = ag.AddEdge(nodes[6], nodes[4])
+	_ = ag.AddEdge(nodes[4], nodes[4])
+	// 8 <-> 9 cycle
+	_ = ag.AddEdge(nodes[7], nodes[8])
+	_ = ag.AddEdge(nodes[8], nodes[7])
+	// 6 is isolated
+
+	s.Require().Equal([][]GroupNode{
+		{nodes[0], nodes[1], nodes[2]},
+		{nodes[3], nodes[4], nodes[6]},
+		{nodes[5]},
+		{nodes[7], nodes[8]},
+	}, ag.WeakComponents())
+
+	// A single edge merges two components.
+	_ = ag.AddEdge(nodes[5], nodes[2])
+	components := ag.WeakComponents()
+	s.Require().Len(components, 3)
+	s.Require().Equal([]GroupNode{nodes[0], nodes[1], nodes[2], nodes[5]}, components[0])
+}
+
+func TestSCCTestSuite(t *testing.T) {
+	suite.Run(t, new(SCCTestSuite))
+}
+
+func TestFindCycleTestSuite(t *testing.T) {
+	suite.Run(t, new(FindCycleTestSuite))
+}
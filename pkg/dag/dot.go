@@ -0,0 +1,58 @@
+package dag
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ToDOT renders the graph in Graphviz DOT format.
+//
+// The graph's Name is used as the digraph name, every group is emitted as a
+// "cluster_<group>" subgraph containing its nodes (labelled with their NodeID),
+// and every adjacency edge is drawn as a directed edge.
+//
+// Groups, nodes and edges are sorted so the output is deterministic.
+//
+// Example:
+//
+//	g := New()
+//	_ = g.AddGroup("build")
+//	_ = g.AddNode(GroupNode{ID: 1, Group: "build"})
+//	fmt.Println(g.ToDOT())
+func (g *Graph) ToDOT() string {
+	var b strings.Builder
+
+	if g.name == "" {
+		b.WriteString("digraph {\n")
+	} else {
+		fmt.Fprintf(&b, "digraph %q {\n", g.name)
+	}
+
+	for _, group := range slices.Sorted(maps.Keys(g.groups)) {
+		fmt.Fprintf(&b, "\tsubgraph %q {\n", "cluster_"+group)
+		fmt.Fprintf(&b, "\t\tlabel=%q;\n", group)
+		for _, id := range slices.Sorted(maps.Keys(g.groups[group])) {
+			fmt.Fprintf(&b, "\t\t%d [label=\"%d\"];\n", id, id)
+		}
+		b.WriteString("\t}\n")
+	}
+
+	for _, from := range slices.Sorted(maps.Keys(g.adjacency)) {
+		for _, to := range slices.Sorted(maps.Keys(g.adjacency[from])) {
+			fmt.Fprintf(&b, "\t%d -> %d;\n", from, to)
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// WriteDOT writes the Graphviz DOT representation of the graph to w.
+// See ToDOT for the output layout.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	_, err := io.WriteString(w, g.ToDOT())
+	return err
+}
@@ -0,0 +1,86 @@
+package dag
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// DOTTestSuite tests Graphviz serialization
+type DOTTestSuite struct {
+	suite.Suite
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func (s *DOTTestSuite) TestToDOT_EmptyGraph() {
+	ag := New()
+	s.Require().Equal("digraph {\n}\n", ag.ToDOT())
+}
+
+func (s *DOTTestSuite) TestToDOT_NamedGraph() {
+	ag := New()
+	ag.name = "pipeline"
+	s.Require().Equal("digraph \"pipeline\" {\n}\n", ag.ToDOT())
+}
+
+func (s *DOTTestSuite) TestToDOT_GroupsAndEdges() {
+	ag := New()
+	_ = ag.AddGroup("test")
+	_ = ag.AddGroup("build")
+
+	node1 := GroupNode{ID: 1, Group: "build"}
+	node2 := GroupNode{ID: 2, Group: "build"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	_ = ag.AddNode(node3)
+	_ = ag.AddNode(node2)
+	_ = ag.AddNode(node1)
+	_ = ag.AddEdge(node2, node3)
+	_ = ag.AddEdge(node1, node3)
+	_ = ag.AddEdge(node1, node2)
+
+	expected := "digraph {\n" +
+		"\tsubgraph \"cluster_build\" {\n" +
+		"\t\tlabel=\"build\";\n" +
+		"\t\t1 [label=\"1\"];\n" +
+		"\t\t2 [label=\"2\"];\n" +
+		"\t}\n" +
+		"\tsubgraph \"cluster_test\" {\n" +
+		"\t\tlabel=\"test\";\n" +
+		"\t\t3 [label=\"3\"];\n" +
+		"\t}\n" +
+		"\t1 -> 2;\n" +
+		"\t1 -> 3;\n" +
+		"\t2 -> 3;\n" +
+		"}\n"
+
+	s.Require().Equal(expected, ag.ToDOT())
+}
+
+func (s *DOTTestSuite) TestWriteDOT() {
+	ag := New()
+	_ = ag.AddGroup("build")
+	_ = ag.AddNode(GroupNode{ID: 1, Group: "build"})
+
+	var buf bytes.Buffer
+	err := ag.WriteDOT(&buf)
+	s.Require().NoError(err)
+	s.Require().Equal(ag.ToDOT(), buf.String())
+}
+
+func (s *DOTTestSuite) TestWriteDOT_WriterError() {
+	ag := New()
+
+	err := ag.WriteDOT(failingWriter{})
+	s.Require().Error(err)
+}
+
+func TestDOTTestSuite(t *testing.T) {
+	suite.Run(t, new(DOTTestSuite))
+}
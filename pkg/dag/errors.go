@@ -6,6 +6,9 @@ import "errors"
 // These errors are returned by various operations to indicate specific
 // failure conditions that callers can handle appropriately.
 var (
+	// ErrNil is returned when a required graph argument is nil.
+	ErrNil = errors.New("nil graph")
+
 	// ErrGroupNotFound is returned when attempting to access a group
 	// that doesn't exist in the graph structure.
 	ErrGroupNotFound = errors.New("group not found")
@@ -22,6 +25,14 @@ var (
 	// an edge with invalid parameters (e.g., self-loops, duplicate edges).
 	ErrInvalidEdge = errors.New("invalid edge")
 
+	// ErrEdgeNotFound is returned, joined with ErrInvalidEdge, when both
+	// endpoints exist but no directed edge connects them.
+	ErrEdgeNotFound = errors.New("edge not found")
+
+	// ErrIDCollision is returned when remapping node IDs would map two
+	// distinct nodes onto the same ID.
+	ErrIDCollision = errors.New("node id collision")
+
 	// ErrInvalidAdjacency is returned when adjacency operations fail
 	// due to structural constraints or invalid node relationships.
 	ErrInvalidAdjacency = errors.New("invalid adjacency")
@@ -30,6 +41,14 @@ var (
 	// encounter inconsistent or invalid reference states.
 	ErrInvalidBackRef = errors.New("invalid backref")
 
+	// ErrNoPath is returned when the destination node cannot be reached
+	// from the source node by following outgoing edges.
+	ErrNoPath = errors.New("no path")
+
+	// ErrCyclicGraph is returned when an operation that requires a
+	// directed acyclic graph encounters a cycle.
+	ErrCyclicGraph = errors.New("cyclic graph")
+
 	// ErrRecoverFromPanic is returned when a panic is recovered during
 	// operation execution, allowing graceful error handling.
 	ErrRecoverFromPanic = errors.New("recover from panic")
@@ -3,8 +3,11 @@
 package dag
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/barnowlsnest/go-datalib/pkg/list"
 	"github.com/barnowlsnest/go-datalib/pkg/node"
@@ -38,6 +41,16 @@ type Graph struct {
 	// adjacency maps each source node to its outgoing edges.
 	// The inner map associates destination nodes with edge IDs.
 	adjacency map[NodeID]map[NodeID]EdgeID
+
+	// weights mirrors adjacency for edges that carry an explicit weight.
+	// Edges without an entry have DefaultEdgeWeight.
+	weights map[NodeID]map[NodeID]float64
+
+	// attrs holds graph-level metadata such as owner, version or description.
+	attrs map[string]string
+
+	// values holds the optional payload attached to each node ID.
+	values map[NodeID]any
 }
 
 // New creates and returns a new empty Graph instance with initialized internal maps.
@@ -46,6 +59,9 @@ func New() *Graph {
 		groups:    make(map[GroupName]map[NodeID]struct{}),
 		backRefs:  make(map[NodeID]map[NodeID]struct{}),
 		adjacency: make(map[NodeID]map[NodeID]EdgeID),
+		weights:   make(map[NodeID]map[NodeID]float64),
+		attrs:     make(map[string]string),
+		values:    make(map[NodeID]any),
 	}
 }
 
@@ -59,6 +75,23 @@ func (g *Graph) ID() ID {
 	return g.id
 }
 
+// SetAttr sets the graph-level attribute key to value, replacing any previous value.
+func (g *Graph) SetAttr(key, value string) {
+	g.attrs[key] = value
+}
+
+// Attr returns the value of the graph-level attribute key and whether it is set.
+func (g *Graph) Attr(key string) (string, bool) {
+	value, ok := g.attrs[key]
+	return value, ok
+}
+
+// Attrs returns a copy of all graph-level attributes.
+// Modifying the returned map does not affect the graph.
+func (g *Graph) Attrs() map[string]string {
+	return maps.Clone(g.attrs)
+}
+
 // checkNodeExists verifies that a node exists in the specified group.
 // Returns ErrGroupNotFound if the group doesn't exist, or ErrNodeNotFound if the node
 // doesn't exist in the group.
@@ -74,6 +107,17 @@ func (g *Graph) checkNodeExists(n GroupNode) error {
 	return nil
 }
 
+// resolve looks up the group a node ID belongs to and returns it as a GroupNode.
+// Returns false if the node isn't a member of any group.
+func (g *Graph) resolve(id NodeID) (GroupNode, bool) {
+	for group, nodes := range g.groups {
+		if _, exists := nodes[id]; exists {
+			return GroupNode{id, group}, true
+		}
+	}
+	return GroupNode{}, false
+}
+
 // forEachEdge iterates over all outgoing edges from the specified node, invoking the
 // provided callback function for each edge. Panics in the callback are recovered and
 // passed to the callback as errors joined with ErrRecoverFromPanic.
@@ -112,6 +156,21 @@ func (g *Graph) removeAdjacency(from, to NodeID) {
 	if len(g.backRefs[to]) == 0 {
 		delete(g.backRefs, to)
 	}
+	delete(g.weights[from], to)
+	if len(g.weights[from]) == 0 {
+		delete(g.weights, from)
+	}
+}
+
+// removeNodeEdges removes every outgoing and incoming edge of the specified node,
+// keeping adjacency and back-references consistent on both ends.
+func (g *Graph) removeNodeEdges(id NodeID) {
+	for to := range g.adjacency[id] {
+		g.removeAdjacency(id, to)
+	}
+	for from := range g.backRefs[id] {
+		g.removeAdjacency(from, id)
+	}
 }
 
 // AddGroup creates a new group with the specified name.
@@ -138,19 +197,58 @@ func (g *Graph) AddNode(n GroupNode) error {
 }
 
 // RemoveNode removes a node from its group and deletes all edges connected to it
-// (both incoming and outgoing).
+// (both incoming and outgoing) together with its value.
 // Returns an error if the node doesn't exist.
 func (g *Graph) RemoveNode(gn GroupNode) error {
 	if nodeErr := g.checkNodeExists(gn); nodeErr != nil {
 		return errors.Join(ErrInvalidEdge, nodeErr)
 	}
-	g.forEachEdge(gn.ID, func(a AdjacencyEdge, err error) {
-		g.removeAdjacency(a.From, a.To)
-	})
+	g.removeNodeEdges(gn.ID)
+	delete(g.values, gn.ID)
 	delete(g.groups[gn.Group], gn.ID)
 	return nil
 }
 
+// RemoveGroup removes the specified group together with all of its nodes and
+// their values. Every edge connected to a removed node is deleted as well,
+// including edges coming from nodes that belong to other groups.
+// Returns ErrGroupNotFound if the group doesn't exist.
+func (g *Graph) RemoveGroup(name GroupName) error {
+	groupNodes, groupExists := g.groups[name]
+	if !groupExists {
+		return errors.Join(ErrGroupNotFound, fmt.Errorf("group [%s]", name))
+	}
+	for id := range groupNodes {
+		g.removeNodeEdges(id)
+		delete(g.values, id)
+	}
+	delete(g.groups, name)
+	return nil
+}
+
+// SetNodeValue attaches an arbitrary payload to the node, replacing any previous
+// value. Values are keyed by node ID and are removed together with the node.
+// They are kept by Clone, Transpose, Merge and RemapIDs but are not serialized
+// to JSON.
+// Returns ErrGroupNotFound or ErrNodeNotFound if the node doesn't exist.
+func (g *Graph) SetNodeValue(gn GroupNode, v any) error {
+	if nodeErr := g.checkNodeExists(gn); nodeErr != nil {
+		return nodeErr
+	}
+	g.values[gn.ID] = v
+	return nil
+}
+
+// NodeValue returns the payload attached to the node with SetNodeValue.
+// Returns false if the node doesn't exist or has no value.
+func (g *Graph) NodeValue(gn GroupNode) (any, bool) {
+	if g.checkNodeExists(gn) != nil {
+		return nil, false
+	}
+	v, ok := g.values[gn.ID]
+	return v, ok
+}
+
 // AddEdge creates a directed edge from 'from' to 'to'.
 // The edge ID is computed as NSum(from.ID, to.ID).
 // Returns ErrInvalidEdge if either node doesn't exist.
@@ -173,6 +271,67 @@ func (g *Graph) AddEdge(from, to GroupNode) error {
 	return nil
 }
 
+// AddEdges adds a batch of directed edges with all-or-nothing semantics.
+// Every endpoint is validated before the graph is mutated; if any endpoint is
+// missing ErrInvalidEdge is returned with the offending pair and the graph is
+// left unchanged.
+func (g *Graph) AddEdges(edges []GroupEdge) error {
+	for _, e := range edges {
+		for _, n := range []GroupNode{e.From, e.To} {
+			if nodeErr := g.checkNodeExists(n); nodeErr != nil {
+				return errors.Join(
+					ErrInvalidEdge,
+					fmt.Errorf("edge [%s/%d -> %s/%d]", e.From.Group, e.From.ID, e.To.Group, e.To.ID),
+					nodeErr,
+				)
+			}
+		}
+	}
+	for _, e := range edges {
+		_ = g.AddEdge(e.From, e.To)
+	}
+	return nil
+}
+
+// AddWeightedEdge creates a directed edge from 'from' to 'to' carrying the given weight.
+// If the edge already exists only its weight is updated.
+// Returns ErrInvalidEdge if either node doesn't exist.
+func (g *Graph) AddWeightedEdge(from, to GroupNode, weight float64) error {
+	if err := g.AddEdge(from, to); err != nil {
+		return err
+	}
+	if _, hasWeights := g.weights[from.ID]; !hasWeights {
+		g.weights[from.ID] = make(map[NodeID]float64)
+	}
+	g.weights[from.ID][to.ID] = weight
+	return nil
+}
+
+// EdgeWeight returns the weight of the directed edge from 'from' to 'to'.
+// Edges created with AddEdge report DefaultEdgeWeight.
+// Returns ErrInvalidEdge if either node or the edge itself doesn't exist; a
+// missing edge between existing nodes is additionally reported as ErrEdgeNotFound.
+func (g *Graph) EdgeWeight(from, to GroupNode) (float64, error) {
+	if fromErr := g.checkNodeExists(from); fromErr != nil {
+		return 0, errors.Join(ErrInvalidEdge, fromErr)
+	}
+	if toErr := g.checkNodeExists(to); toErr != nil {
+		return 0, errors.Join(ErrInvalidEdge, toErr)
+	}
+	if _, edgeExists := g.adjacency[from.ID][to.ID]; !edgeExists {
+		return 0, errors.Join(ErrInvalidEdge, ErrEdgeNotFound, fmt.Errorf("edge [%d -> %d]", from.ID, to.ID))
+	}
+	return g.weight(from.ID, to.ID), nil
+}
+
+// weight returns the weight of an existing edge, falling back to DefaultEdgeWeight.
+func (g *Graph) weight(from, to NodeID) float64 {
+	if w, weighted := g.weights[from][to]; weighted {
+		return w
+	}
+	return DefaultEdgeWeight
+}
+
 // RemoveEdge deletes the directed edge from 'from' to 'to'.
 // Returns ErrInvalidEdge if either node doesn't exist.
 // Removing a non-existent edge is a no-op (idempotent).
@@ -187,6 +346,41 @@ func (g *Graph) RemoveEdge(from, to GroupNode) error {
 	return nil
 }
 
+// AddEdgeByID creates a directed edge between two nodes identified only by ID.
+// Each node's group is looked up by scanning the groups before delegating to AddEdge.
+// Returns ErrNodeNotFound (joined with ErrInvalidEdge) if either ID isn't in any group.
+func (g *Graph) AddEdgeByID(from, to NodeID) error {
+	fromNode, toNode, err := g.resolveEdge(from, to)
+	if err != nil {
+		return err
+	}
+	return g.AddEdge(fromNode, toNode)
+}
+
+// RemoveEdgeByID deletes the directed edge between two nodes identified only by ID.
+// Each node's group is looked up by scanning the groups before delegating to RemoveEdge.
+// Returns ErrNodeNotFound (joined with ErrInvalidEdge) if either ID isn't in any group.
+func (g *Graph) RemoveEdgeByID(from, to NodeID) error {
+	fromNode, toNode, err := g.resolveEdge(from, to)
+	if err != nil {
+		return err
+	}
+	return g.RemoveEdge(fromNode, toNode)
+}
+
+// resolveEdge resolves both endpoints of an edge to their groups.
+func (g *Graph) resolveEdge(from, to NodeID) (GroupNode, GroupNode, error) {
+	fromNode, fromExists := g.resolve(from)
+	if !fromExists {
+		return GroupNode{}, GroupNode{}, errors.Join(ErrInvalidEdge, ErrNodeNotFound, fmt.Errorf("node [%d]", from))
+	}
+	toNode, toExists := g.resolve(to)
+	if !toExists {
+		return GroupNode{}, GroupNode{}, errors.Join(ErrInvalidEdge, ErrNodeNotFound, fmt.Errorf("node [%d]", to))
+	}
+	return fromNode, toNode, nil
+}
+
 // HasNode returns true if the node exists in the specified group.
 func (g *Graph) HasNode(gn GroupNode) bool {
 	if err := g.checkNodeExists(gn); err != nil {
@@ -213,6 +407,24 @@ func (g *Graph) HasEdge(from, to GroupNode) bool {
 	return true
 }
 
+// GetEdge returns the ID of the directed edge from 'from' to 'to', as computed by
+// AddEdge with NSum(from.ID, to.ID).
+// Returns ErrInvalidEdge if either node or the edge itself doesn't exist; a
+// missing edge between existing nodes is additionally reported as ErrEdgeNotFound.
+func (g *Graph) GetEdge(from, to GroupNode) (EdgeID, error) {
+	if fromErr := g.checkNodeExists(from); fromErr != nil {
+		return 0, errors.Join(ErrInvalidEdge, fromErr)
+	}
+	if toErr := g.checkNodeExists(to); toErr != nil {
+		return 0, errors.Join(ErrInvalidEdge, toErr)
+	}
+	edge, edgeExists := g.adjacency[from.ID][to.ID]
+	if !edgeExists {
+		return 0, errors.Join(ErrInvalidEdge, ErrEdgeNotFound, fmt.Errorf("edge [%d -> %d]", from.ID, to.ID))
+	}
+	return edge, nil
+}
+
 // IsAcyclic performs cycle detection using Kahn's algorithm (topological sort).
 // It returns a channel that will receive true if the graph is acyclic, false otherwise.
 // The check runs asynchronously in a goroutine. An empty graph is considered acyclic.
@@ -334,16 +546,78 @@ func (g *Graph) GetBackRefsOf(gn GroupNode) ([]GroupNode, error) {
 	res := make([]GroupNode, len(backRefs))
 	var i int
 	for ref := range backRefs {
-		for group, nodes := range g.groups {
-			if _, exists := nodes[ref]; exists {
-				res[i] = GroupNode{ref, group}
-			}
+		if gn, ok := g.resolve(ref); ok {
+			res[i] = gn
 		}
 		i++
 	}
 	return res, nil
 }
 
+// GetForwardRefsOf returns all nodes the specified node has edges pointing to.
+// Returns ErrInvalidAdjacency if the node doesn't exist. A node without outgoing
+// edges yields an empty slice and no error.
+//
+// Note: The returned slice order is non-deterministic due to map iteration.
+func (g *Graph) GetForwardRefsOf(gn GroupNode) ([]GroupNode, error) {
+	if nodeErr := g.checkNodeExists(gn); nodeErr != nil {
+		return nil, errors.Join(ErrInvalidAdjacency, nodeErr)
+	}
+	neighbours := g.adjacency[gn.ID]
+	res := make([]GroupNode, 0, len(neighbours))
+	for to := range neighbours {
+		if ref, ok := g.resolve(to); ok {
+			res = append(res, ref)
+		}
+	}
+	return res, nil
+}
+
+// Neighbours returns every node connected to the specified node regardless of
+// edge direction: the union of its outgoing targets and incoming sources, with
+// each node listed once and sorted by ID. A node without edges yields an empty
+// slice and no error.
+// Returns ErrInvalidAdjacency if the node doesn't exist.
+func (g *Graph) Neighbours(gn GroupNode) ([]GroupNode, error) {
+	if nodeErr := g.checkNodeExists(gn); nodeErr != nil {
+		return nil, errors.Join(ErrInvalidAdjacency, nodeErr)
+	}
+	ids := make(map[NodeID]struct{}, len(g.adjacency[gn.ID])+len(g.backRefs[gn.ID]))
+	for to := range g.adjacency[gn.ID] {
+		ids[to] = struct{}{}
+	}
+	for from := range g.backRefs[gn.ID] {
+		ids[from] = struct{}{}
+	}
+	res := make([]GroupNode, 0, len(ids))
+	for _, id := range slices.Sorted(maps.Keys(ids)) {
+		if ref, ok := g.resolve(id); ok {
+			res = append(res, ref)
+		}
+	}
+	return res, nil
+}
+
+// InDegree returns the number of edges pointing to the specified node.
+// Returns ErrNodeNotFound if the node doesn't exist. A node without incoming
+// edges has an in-degree of 0.
+func (g *Graph) InDegree(gn GroupNode) (int, error) {
+	if !g.HasNode(gn) {
+		return 0, errors.Join(ErrNodeNotFound, fmt.Errorf("group [%s] node [%d]", gn.Group, gn.ID))
+	}
+	return len(g.backRefs[gn.ID]), nil
+}
+
+// OutDegree returns the number of edges leaving the specified node.
+// Returns ErrNodeNotFound if the node doesn't exist. A node without outgoing
+// edges has an out-degree of 0.
+func (g *Graph) OutDegree(gn GroupNode) (int, error) {
+	if !g.HasNode(gn) {
+		return 0, errors.Join(ErrNodeNotFound, fmt.Errorf("group [%s] node [%d]", gn.Group, gn.ID))
+	}
+	return len(g.adjacency[gn.ID]), nil
+}
+
 // GetNodes returns all nodes belonging to the specified group.
 // Returns ErrGroupNotFound if the group doesn't exist.
 //
@@ -374,3 +648,47 @@ func (g *Graph) ListGroups() []GroupName {
 	}
 	return res
 }
+
+// GetNodesSorted returns all nodes belonging to the specified group, sorted
+// ascending by ID. Prefer GetNodes when order doesn't matter.
+// Returns ErrGroupNotFound if the group doesn't exist.
+func (g *Graph) GetNodesSorted(group GroupName) ([]GroupNode, error) {
+	res, err := g.GetNodes(group)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(res, func(a, b GroupNode) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return res, nil
+}
+
+// ListGroupsSorted returns all group names in the graph, sorted lexicographically.
+// Prefer ListGroups when order doesn't matter.
+func (g *Graph) ListGroupsSorted() []GroupName {
+	return slices.Sorted(maps.Keys(g.groups))
+}
+
+// NodeCount returns the total number of nodes across all groups.
+// A node ID added to several groups is counted once per group.
+func (g *Graph) NodeCount() int {
+	var count int
+	for _, nodes := range g.groups {
+		count += len(nodes)
+	}
+	return count
+}
+
+// EdgeCount returns the total number of directed edges in the graph.
+func (g *Graph) EdgeCount() int {
+	var count int
+	for _, neighbours := range g.adjacency {
+		count += len(neighbours)
+	}
+	return count
+}
+
+// GroupCount returns the number of groups in the graph.
+func (g *Graph) GroupCount() int {
+	return len(g.groups)
+}
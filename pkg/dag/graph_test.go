@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/barnowlsnest/go-datalib/pkg/serial"
 )
 
 // BasicFunctionalityTestSuite tests core DAG functionality
@@ -24,6 +26,78 @@ func (s *BasicFunctionalityTestSuite) TestNewAdjacencyGroups() {
 	s.Require().Equal(0, len(ag.groups))
 }
 
+func (s *BasicFunctionalityTestSuite) TestAttrs() {
+	ag := New()
+	s.Require().Empty(ag.Attrs())
+	_, ok := ag.Attr("owner")
+	s.Require().False(ok)
+
+	ag.SetAttr("owner", "platform")
+	ag.SetAttr("version", "1")
+	ag.SetAttr("version", "2")
+
+	owner, ok := ag.Attr("owner")
+	s.Require().True(ok)
+	s.Require().Equal("platform", owner)
+	s.Require().Equal(map[string]string{"owner": "platform", "version": "2"}, ag.Attrs())
+
+	// Attrs returns a copy.
+	attrs := ag.Attrs()
+	attrs["owner"] = "changed"
+	owner, _ = ag.Attr("owner")
+	s.Require().Equal("platform", owner)
+}
+
+func (s *BasicFunctionalityTestSuite) TestNodeValues() {
+	ag := New()
+	_ = ag.AddGroup("users")
+	alice := GroupNode{ID: 1, Group: "users"}
+	bob := GroupNode{ID: 2, Group: "users"}
+	_ = ag.AddNode(alice)
+	_ = ag.AddNode(bob)
+
+	_, ok := ag.NodeValue(alice)
+	s.Require().False(ok)
+
+	s.Require().NoError(ag.SetNodeValue(alice, "admin"))
+	s.Require().NoError(ag.SetNodeValue(bob, 42))
+	s.Require().NoError(ag.SetNodeValue(bob, 43))
+
+	v, ok := ag.NodeValue(alice)
+	s.Require().True(ok)
+	s.Require().Equal("admin", v)
+	v, ok = ag.NodeValue(bob)
+	s.Require().True(ok)
+	s.Require().Equal(43, v)
+
+	// A nil value is still a value.
+	s.Require().NoError(ag.SetNodeValue(alice, nil))
+	v, ok = ag.NodeValue(alice)
+	s.Require().True(ok)
+	s.Require().Nil(v)
+
+	_, ok = ag.NodeValue(GroupNode{ID: 2, Group: "missing"})
+	s.Require().False(ok)
+
+	err := ag.SetNodeValue(GroupNode{ID: 3, Group: "users"}, "x")
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	err = ag.SetNodeValue(GroupNode{ID: 1, Group: "missing"}, "x")
+	s.Require().ErrorIs(err, ErrGroupNotFound)
+
+	s.Require().NoError(ag.RemoveNode(bob))
+	_, ok = ag.NodeValue(bob)
+	s.Require().False(ok)
+	s.Require().NotContains(ag.values, bob.ID)
+
+	// Re-adding the node does not resurrect its old value.
+	_ = ag.AddNode(bob)
+	_, ok = ag.NodeValue(bob)
+	s.Require().False(ok)
+
+	s.Require().NoError(ag.RemoveGroup("users"))
+	s.Require().Empty(ag.values)
+}
+
 func (s *BasicFunctionalityTestSuite) TestAddGroup() {
 	ag := New()
 
@@ -101,6 +175,29 @@ func (s *BasicFunctionalityTestSuite) TestAddEdge_NonExistentNode() {
 	s.Require().ErrorIs(err, ErrInvalidEdge)
 }
 
+func (s *BasicFunctionalityTestSuite) TestGetEdge() {
+	ag := New()
+	_ = ag.AddGroup("users")
+
+	from := GroupNode{ID: 1, Group: "users"}
+	to := GroupNode{ID: 2, Group: "users"}
+	_ = ag.AddNode(from)
+	_ = ag.AddNode(to)
+	_ = ag.AddEdge(from, to)
+
+	edge, err := ag.GetEdge(from, to)
+	s.Require().NoError(err)
+	s.Require().Equal(serial.NSum(from.ID, to.ID), edge)
+
+	_, err = ag.GetEdge(to, from)
+	s.Require().ErrorIs(err, ErrEdgeNotFound)
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+
+	_, err = ag.GetEdge(from, GroupNode{ID: 3, Group: "users"})
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+	s.Require().NotErrorIs(err, ErrEdgeNotFound)
+}
+
 func (s *BasicFunctionalityTestSuite) TestRemoveEdge() {
 	ag := New()
 	_ = ag.AddGroup("users")
@@ -118,6 +215,53 @@ func (s *BasicFunctionalityTestSuite) TestRemoveEdge() {
 	s.Require().False(ag.HasEdge(from, to))
 }
 
+func (s *BasicFunctionalityTestSuite) TestAddEdgeByID() {
+	ag := New()
+	_ = ag.AddGroup("users")
+	_ = ag.AddGroup("products")
+
+	user := GroupNode{ID: 1, Group: "users"}
+	product := GroupNode{ID: 2, Group: "products"}
+	_ = ag.AddNode(user)
+	_ = ag.AddNode(product)
+
+	s.Require().NoError(ag.AddEdgeByID(user.ID, product.ID))
+	s.Require().True(ag.HasEdge(user, product))
+	s.Require().False(ag.HasEdge(product, user))
+
+	err := ag.AddEdgeByID(user.ID, 3)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+
+	err = ag.AddEdgeByID(3, user.ID)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().Equal(1, ag.EdgeCount())
+}
+
+func (s *BasicFunctionalityTestSuite) TestRemoveEdgeByID() {
+	ag := New()
+	_ = ag.AddGroup("users")
+	_ = ag.AddGroup("products")
+
+	user := GroupNode{ID: 1, Group: "users"}
+	product := GroupNode{ID: 2, Group: "products"}
+	_ = ag.AddNode(user)
+	_ = ag.AddNode(product)
+	_ = ag.AddEdge(user, product)
+
+	s.Require().NoError(ag.RemoveEdgeByID(user.ID, product.ID))
+	s.Require().False(ag.HasEdge(user, product))
+	s.Require().Empty(ag.adjacency)
+	s.Require().Empty(ag.backRefs)
+
+	// removing a missing edge between existing nodes is a no-op
+	s.Require().NoError(ag.RemoveEdgeByID(user.ID, product.ID))
+
+	err := ag.RemoveEdgeByID(user.ID, 3)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+}
+
 func (s *BasicFunctionalityTestSuite) TestRemoveNode() {
 	ag := New()
 	_ = ag.AddGroup("users")
@@ -196,6 +340,24 @@ func (s *MemoryConsistencyTestSuite) TestRemoveNode_CleansUpAllReferences() {
 	}
 }
 
+func (s *MemoryConsistencyTestSuite) TestRemoveNode_CleansUpIncomingEdges() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "test"}
+	node2 := GroupNode{ID: 2, Group: "test"}
+	_ = ag.AddNode(node1)
+	_ = ag.AddNode(node2)
+	_ = ag.AddEdge(node1, node2)
+
+	_ = ag.RemoveNode(node2)
+
+	_, hasAdjacency := ag.adjacency[node1.ID]
+	s.Require().False(hasAdjacency, "node1 should not keep an edge to removed node2")
+	_, hasBackRefs := ag.backRefs[node2.ID]
+	s.Require().False(hasBackRefs, "removed node2 should not keep back-references")
+}
+
 func (s *MemoryConsistencyTestSuite) TestBackRefsConsistency() {
 	ag := New()
 	_ = ag.AddGroup("test")
@@ -526,6 +688,146 @@ func (s *BackRefsTestSuite) TestGetBackRefsOf_NoBackRefs() {
 	s.Require().Nil(backRefs)
 }
 
+func (s *BackRefsTestSuite) TestGetForwardRefsOf() {
+	ag := New()
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "build"}
+	node2 := GroupNode{ID: 2, Group: "build"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	node4 := GroupNode{ID: 4, Group: "test"}
+	_ = ag.AddNode(node1)
+	_ = ag.AddNode(node2)
+	_ = ag.AddNode(node3)
+	_ = ag.AddNode(node4)
+
+	// node1 points to nodes across both groups
+	_ = ag.AddEdge(node1, node2)
+	_ = ag.AddEdge(node1, node3)
+	_ = ag.AddEdge(node1, node4)
+	_ = ag.AddEdge(node2, node4)
+
+	forwardRefs, err := ag.GetForwardRefsOf(node1)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]GroupNode{node2, node3, node4}, forwardRefs)
+}
+
+func (s *BackRefsTestSuite) TestGetForwardRefsOf_NoForwardRefs() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	node := GroupNode{ID: 1, Group: "test"}
+	_ = ag.AddNode(node)
+
+	forwardRefs, err := ag.GetForwardRefsOf(node)
+	s.Require().NoError(err)
+	s.Require().NotNil(forwardRefs)
+	s.Require().Empty(forwardRefs)
+}
+
+func (s *BackRefsTestSuite) TestGetForwardRefsOf_NonExistentNode() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	forwardRefs, err := ag.GetForwardRefsOf(GroupNode{ID: 1, Group: "test"})
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, ErrInvalidAdjacency)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().Nil(forwardRefs)
+}
+
+func (s *BackRefsTestSuite) TestNeighbours() {
+	ag := New()
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "build"}
+	node2 := GroupNode{ID: 2, Group: "build"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	node4 := GroupNode{ID: 4, Group: "test"}
+	node5 := GroupNode{ID: 5, Group: "test"}
+	for _, n := range []GroupNode{node1, node2, node3, node4, node5} {
+		_ = ag.AddNode(n)
+	}
+
+	// node2 has an outgoing edge to node4, incoming edges from node1 and node3,
+	// and a two-way link with node3 that must only be reported once.
+	_ = ag.AddEdge(node1, node2)
+	_ = ag.AddEdge(node3, node2)
+	_ = ag.AddEdge(node2, node3)
+	_ = ag.AddEdge(node2, node4)
+
+	neighbours, err := ag.Neighbours(node2)
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{node1, node3, node4}, neighbours)
+
+	neighbours, err = ag.Neighbours(node1)
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{node2}, neighbours)
+
+	neighbours, err = ag.Neighbours(node5)
+	s.Require().NoError(err)
+	s.Require().NotNil(neighbours)
+	s.Require().Empty(neighbours)
+
+	neighbours, err = ag.Neighbours(GroupNode{ID: 9, Group: "test"})
+	s.Require().ErrorIs(err, ErrInvalidAdjacency)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().Nil(neighbours)
+}
+
+func (s *BackRefsTestSuite) TestDegree() {
+	ag := New()
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "build"}
+	node2 := GroupNode{ID: 2, Group: "build"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	node4 := GroupNode{ID: 4, Group: "test"}
+	_ = ag.AddNode(node1)
+	_ = ag.AddNode(node2)
+	_ = ag.AddNode(node3)
+	_ = ag.AddNode(node4)
+
+	_ = ag.AddEdge(node1, node2)
+	_ = ag.AddEdge(node1, node3)
+	_ = ag.AddEdge(node2, node3)
+
+	cases := []struct {
+		node    GroupNode
+		in, out int
+	}{
+		{node1, 0, 2},
+		{node2, 1, 1},
+		{node3, 2, 0},
+		{node4, 0, 0},
+	}
+	for _, c := range cases {
+		in, err := ag.InDegree(c.node)
+		s.Require().NoError(err)
+		s.Require().Equal(c.in, in, "in-degree of node %d", c.node.ID)
+
+		out, err := ag.OutDegree(c.node)
+		s.Require().NoError(err)
+		s.Require().Equal(c.out, out, "out-degree of node %d", c.node.ID)
+	}
+}
+
+func (s *BackRefsTestSuite) TestDegree_NonExistentNode() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	in, err := ag.InDegree(GroupNode{ID: 1, Group: "test"})
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().Zero(in)
+
+	out, err := ag.OutDegree(GroupNode{ID: 1, Group: "missing"})
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().Zero(out)
+}
+
 // ForEachNeighbourTestSuite tests neighbor iteration
 type ForEachNeighbourTestSuite struct {
 	suite.Suite
@@ -597,6 +899,41 @@ func (s *GroupOperationsTestSuite) TestListGroups() {
 	s.Require().Equal(2, len(groups))
 }
 
+func (s *GroupOperationsTestSuite) TestCounts() {
+	ag := New()
+	s.Require().Equal(0, ag.NodeCount())
+	s.Require().Equal(0, ag.EdgeCount())
+	s.Require().Equal(0, ag.GroupCount())
+
+	_ = ag.AddGroup("users")
+	_ = ag.AddGroup("products")
+	_ = ag.AddGroup("empty")
+
+	user1 := GroupNode{ID: 1, Group: "users"}
+	user2 := GroupNode{ID: 2, Group: "users"}
+	product := GroupNode{ID: 3, Group: "products"}
+	_ = ag.AddNode(user1)
+	_ = ag.AddNode(user2)
+	_ = ag.AddNode(product)
+	_ = ag.AddEdge(user1, product)
+	_ = ag.AddEdge(user2, product)
+	_ = ag.AddEdge(user1, user2)
+	_ = ag.AddEdge(user1, user2)
+
+	s.Require().Equal(3, ag.NodeCount())
+	s.Require().Equal(3, ag.EdgeCount())
+	s.Require().Equal(3, ag.GroupCount())
+
+	_ = ag.RemoveNode(product)
+	s.Require().Equal(2, ag.NodeCount())
+	s.Require().Equal(1, ag.EdgeCount())
+
+	_ = ag.RemoveGroup("users")
+	s.Require().Equal(0, ag.NodeCount())
+	s.Require().Equal(0, ag.EdgeCount())
+	s.Require().Equal(2, ag.GroupCount())
+}
+
 func (s *GroupOperationsTestSuite) TestGetNodes() {
 	ag := New()
 	_ = ag.AddGroup("test")
@@ -620,6 +957,248 @@ func (s *GroupOperationsTestSuite) TestGetNodes_NonExistentGroup() {
 	s.Require().Nil(nodes)
 }
 
+func (s *GroupOperationsTestSuite) TestListGroupsSorted() {
+	ag := New()
+	s.Require().Empty(ag.ListGroupsSorted())
+
+	for _, name := range []GroupName{"users", "products", "orders", "Zeta"} {
+		_ = ag.AddGroup(name)
+	}
+
+	s.Require().Equal([]GroupName{"Zeta", "orders", "products", "users"}, ag.ListGroupsSorted())
+}
+
+func (s *GroupOperationsTestSuite) TestGetNodesSorted() {
+	ag := New()
+	_ = ag.AddGroup("test")
+	_ = ag.AddGroup("empty")
+
+	for _, id := range []NodeID{42, 7, 100, 1, 13} {
+		_ = ag.AddNode(GroupNode{ID: id, Group: "test"})
+	}
+
+	nodes, err := ag.GetNodesSorted("test")
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{
+		{ID: 1, Group: "test"},
+		{ID: 7, Group: "test"},
+		{ID: 13, Group: "test"},
+		{ID: 42, Group: "test"},
+		{ID: 100, Group: "test"},
+	}, nodes)
+
+	nodes, err = ag.GetNodesSorted("empty")
+	s.Require().NoError(err)
+	s.Require().Empty(nodes)
+
+	_, err = ag.GetNodesSorted("nonexistent")
+	s.Require().ErrorIs(err, ErrGroupNotFound)
+}
+
+func (s *GroupOperationsTestSuite) TestRemoveGroup() {
+	ag := New()
+	_ = ag.AddGroup("users")
+	_ = ag.AddGroup("products")
+
+	user1 := GroupNode{ID: 1, Group: "users"}
+	user2 := GroupNode{ID: 2, Group: "users"}
+	product := GroupNode{ID: 3, Group: "products"}
+	_ = ag.AddNode(user1)
+	_ = ag.AddNode(user2)
+	_ = ag.AddNode(product)
+	_ = ag.AddEdge(user1, user2)
+	_ = ag.AddEdge(user2, product)
+
+	err := ag.RemoveGroup("users")
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]GroupName{"products"}, ag.ListGroups())
+	s.Require().False(ag.HasNode(user1))
+	s.Require().False(ag.HasNode(user2))
+	s.Require().True(ag.HasNode(product))
+	s.Require().Equal(0, len(ag.adjacency))
+	s.Require().Equal(0, len(ag.backRefs))
+}
+
+func (s *GroupOperationsTestSuite) TestRemoveGroup_PurgesIncomingBackRefs() {
+	ag := New()
+	_ = ag.AddGroup("users")
+	_ = ag.AddGroup("products")
+
+	user := GroupNode{ID: 1, Group: "users"}
+	product1 := GroupNode{ID: 2, Group: "products"}
+	product2 := GroupNode{ID: 3, Group: "products"}
+	_ = ag.AddNode(user)
+	_ = ag.AddNode(product1)
+	_ = ag.AddNode(product2)
+
+	// Nodes in another group point into the removed group
+	_ = ag.AddEdge(product1, user)
+	_ = ag.AddEdge(product1, product2)
+
+	err := ag.RemoveGroup("users")
+	s.Require().NoError(err)
+
+	_, hasBackRefs := ag.backRefs[user.ID]
+	s.Require().False(hasBackRefs, "removed node should not keep back-references")
+	_, hasEdge := ag.adjacency[product1.ID][user.ID]
+	s.Require().False(hasEdge, "edge into removed group should be deleted")
+	s.Require().True(ag.HasEdge(product1, product2))
+
+	backRefs, err := ag.GetBackRefsOf(product2)
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{product1}, backRefs)
+}
+
+func (s *GroupOperationsTestSuite) TestRemoveGroup_NonExistentGroup() {
+	ag := New()
+
+	err := ag.RemoveGroup("nonexistent")
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, ErrGroupNotFound)
+}
+
+func (s *GroupOperationsTestSuite) TestAddEdges() {
+	ag := New()
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "build"}
+	node2 := GroupNode{ID: 2, Group: "build"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	_ = ag.AddNode(node1)
+	_ = ag.AddNode(node2)
+	_ = ag.AddNode(node3)
+
+	err := ag.AddEdges([]GroupEdge{
+		{From: node1, To: node2},
+		{From: node1, To: node3},
+		{From: node2, To: node3},
+		{From: node2, To: node3},
+	})
+	s.Require().NoError(err)
+	s.Require().True(ag.HasEdge(node1, node2))
+	s.Require().True(ag.HasEdge(node1, node3))
+	s.Require().True(ag.HasEdge(node2, node3))
+
+	refs, err := ag.GetBackRefsOf(node3)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]GroupNode{node1, node2}, refs)
+}
+
+func (s *GroupOperationsTestSuite) TestAddEdges_AllOrNothing() {
+	ag := New()
+	_ = ag.AddGroup("build")
+
+	node1 := GroupNode{ID: 1, Group: "build"}
+	node2 := GroupNode{ID: 2, Group: "build"}
+	missing := GroupNode{ID: 3, Group: "build"}
+	_ = ag.AddNode(node1)
+	_ = ag.AddNode(node2)
+
+	err := ag.AddEdges([]GroupEdge{
+		{From: node1, To: node2},
+		{From: node2, To: missing},
+	})
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().Contains(err.Error(), "build/2 -> build/3")
+
+	s.Require().False(ag.HasEdge(node1, node2))
+	s.Require().Empty(ag.adjacency)
+	s.Require().Empty(ag.backRefs)
+}
+
+func (s *GroupOperationsTestSuite) TestAddEdges_Empty() {
+	ag := New()
+	s.Require().NoError(ag.AddEdges(nil))
+	s.Require().Empty(ag.adjacency)
+}
+
+// WeightedEdgesTestSuite tests edge weights
+type WeightedEdgesTestSuite struct {
+	suite.Suite
+}
+
+func (s *WeightedEdgesTestSuite) newGraph() (ag *Graph, from, to GroupNode) {
+	ag = New()
+	_ = ag.AddGroup("test")
+	from = GroupNode{ID: 1, Group: "test"}
+	to = GroupNode{ID: 2, Group: "test"}
+	_ = ag.AddNode(from)
+	_ = ag.AddNode(to)
+	return ag, from, to
+}
+
+func (s *WeightedEdgesTestSuite) TestAddWeightedEdge() {
+	ag, from, to := s.newGraph()
+
+	err := ag.AddWeightedEdge(from, to, 2.5)
+	s.Require().NoError(err)
+	s.Require().True(ag.HasEdge(from, to))
+
+	weight, err := ag.EdgeWeight(from, to)
+	s.Require().NoError(err)
+	s.Require().Equal(2.5, weight)
+
+	// Re-adding updates the weight
+	s.Require().NoError(ag.AddWeightedEdge(from, to, 7))
+	weight, err = ag.EdgeWeight(from, to)
+	s.Require().NoError(err)
+	s.Require().Equal(7.0, weight)
+}
+
+func (s *WeightedEdgesTestSuite) TestAddWeightedEdge_NonExistentNode() {
+	ag, from, _ := s.newGraph()
+
+	err := ag.AddWeightedEdge(from, GroupNode{ID: 3, Group: "test"}, 1)
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+	s.Require().Equal(0, len(ag.weights))
+}
+
+func (s *WeightedEdgesTestSuite) TestEdgeWeight_DefaultForUnweightedEdge() {
+	ag, from, to := s.newGraph()
+	_ = ag.AddEdge(from, to)
+
+	weight, err := ag.EdgeWeight(from, to)
+	s.Require().NoError(err)
+	s.Require().Equal(DefaultEdgeWeight, weight)
+}
+
+func (s *WeightedEdgesTestSuite) TestEdgeWeight_MissingEdge() {
+	ag, from, to := s.newGraph()
+
+	_, err := ag.EdgeWeight(from, to)
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+	s.Require().ErrorIs(err, ErrEdgeNotFound)
+
+	_, err = ag.EdgeWeight(from, GroupNode{ID: 3, Group: "test"})
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+}
+
+func (s *WeightedEdgesTestSuite) TestRemoveEdge_CleansUpWeights() {
+	ag, from, to := s.newGraph()
+	_ = ag.AddWeightedEdge(from, to, 3)
+
+	s.Require().NoError(ag.RemoveEdge(from, to))
+	s.Require().Equal(0, len(ag.weights))
+
+	// Re-adding without weight must not resurrect the old one
+	_ = ag.AddEdge(from, to)
+	weight, err := ag.EdgeWeight(from, to)
+	s.Require().NoError(err)
+	s.Require().Equal(DefaultEdgeWeight, weight)
+}
+
+func (s *WeightedEdgesTestSuite) TestRemoveNode_CleansUpWeights() {
+	ag, from, to := s.newGraph()
+	_ = ag.AddWeightedEdge(from, to, 3)
+	_ = ag.AddWeightedEdge(to, from, 4)
+
+	s.Require().NoError(ag.RemoveNode(to))
+	s.Require().Equal(0, len(ag.weights))
+}
+
 // ConcurrencyTestSuite tests concurrent operations
 type ConcurrencyTestSuite struct {
 	suite.Suite
@@ -821,6 +1400,10 @@ func TestGroupOperationsTestSuite(t *testing.T) {
 	suite.Run(t, new(GroupOperationsTestSuite))
 }
 
+func TestWeightedEdgesTestSuite(t *testing.T) {
+	suite.Run(t, new(WeightedEdgesTestSuite))
+}
+
 func TestConcurrencyTestSuite(t *testing.T) {
 	suite.Run(t, new(ConcurrencyTestSuite))
 }
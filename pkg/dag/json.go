@@ -0,0 +1,107 @@
+package dag
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/barnowlsnest/go-datalib/pkg/serial"
+)
+
+type (
+	// graphJSON is the wire representation of a Graph.
+	graphJSON struct {
+		ID     ID                     `json:"id"`
+		Name   Name                   `json:"name"`
+		Attrs  map[string]string      `json:"attrs,omitempty"`
+		Groups map[GroupName][]NodeID `json:"groups"`
+		Edges  []edgeJSON             `json:"edges"`
+	}
+
+	// edgeJSON is the wire representation of a single directed edge.
+	// Edge IDs are not persisted; they are recomputed on load.
+	// Weight is only present for edges created with an explicit weight.
+	edgeJSON struct {
+		From   NodeID   `json:"from"`
+		To     NodeID   `json:"to"`
+		Weight *float64 `json:"weight,omitempty"`
+	}
+)
+
+// MarshalJSON serializes the graph's identity, attributes, groups with their node
+// memberships, and all adjacency edges including explicit weights. Nodes and
+// edges are sorted so the output is deterministic.
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	out := graphJSON{
+		ID:     g.id,
+		Name:   g.name,
+		Attrs:  g.attrs,
+		Groups: make(map[GroupName][]NodeID, len(g.groups)),
+		Edges:  make([]edgeJSON, 0),
+	}
+	for group, nodes := range g.groups {
+		out.Groups[group] = slices.Sorted(maps.Keys(nodes))
+	}
+	for _, from := range slices.Sorted(maps.Keys(g.adjacency)) {
+		for _, to := range slices.Sorted(maps.Keys(g.adjacency[from])) {
+			e := edgeJSON{From: from, To: to}
+			if w, weighted := g.weights[from][to]; weighted {
+				e.Weight = &w
+			}
+			out.Edges = append(out.Edges, e)
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON rebuilds the graph from the output of MarshalJSON, restoring
+// attributes, groups, node memberships, adjacency and back-references.
+// Returns ErrInvalidEdge if an edge references a node that isn't a member of any
+// group. On error the receiver is left unchanged.
+func (g *Graph) UnmarshalJSON(data []byte) error {
+	var in graphJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	loaded := New()
+	loaded.id = in.ID
+	loaded.name = in.Name
+	maps.Copy(loaded.attrs, in.Attrs)
+	known := make(map[NodeID]struct{})
+	for group, ids := range in.Groups {
+		loaded.groups[group] = make(map[NodeID]struct{}, len(ids))
+		for _, id := range ids {
+			loaded.groups[group][id] = struct{}{}
+			known[id] = struct{}{}
+		}
+	}
+
+	for _, e := range in.Edges {
+		if _, ok := known[e.From]; !ok {
+			return errors.Join(ErrInvalidEdge, ErrNodeNotFound, fmt.Errorf("edge [%d -> %d] source node [%d]", e.From, e.To, e.From))
+		}
+		if _, ok := known[e.To]; !ok {
+			return errors.Join(ErrInvalidEdge, ErrNodeNotFound, fmt.Errorf("edge [%d -> %d] target node [%d]", e.From, e.To, e.To))
+		}
+		if _, hasNeighbours := loaded.adjacency[e.From]; !hasNeighbours {
+			loaded.adjacency[e.From] = make(map[NodeID]EdgeID)
+		}
+		if _, hasRefs := loaded.backRefs[e.To]; !hasRefs {
+			loaded.backRefs[e.To] = make(map[NodeID]struct{})
+		}
+		loaded.adjacency[e.From][e.To] = serial.NSum(e.From, e.To)
+		loaded.backRefs[e.To][e.From] = struct{}{}
+		if e.Weight != nil {
+			if _, hasWeights := loaded.weights[e.From]; !hasWeights {
+				loaded.weights[e.From] = make(map[NodeID]float64)
+			}
+			loaded.weights[e.From][e.To] = *e.Weight
+		}
+	}
+
+	*g = *loaded
+	return nil
+}
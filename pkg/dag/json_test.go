@@ -0,0 +1,115 @@
+package dag
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// JSONTestSuite tests JSON serialization round-trips
+type JSONTestSuite struct {
+	suite.Suite
+}
+
+func (s *JSONTestSuite) TestRoundTrip() {
+	ag := New()
+	ag.id = uuid.New()
+	ag.name = "pipeline"
+	ag.SetAttr("owner", "platform")
+	ag.SetAttr("version", "3")
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("test")
+	_ = ag.AddGroup("empty")
+
+	node1 := GroupNode{ID: 1, Group: "build"}
+	node2 := GroupNode{ID: 2, Group: "build"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	node4 := GroupNode{ID: 4, Group: "test"}
+	all := []GroupNode{node1, node2, node3, node4}
+	for _, n := range all {
+		_ = ag.AddNode(n)
+	}
+	_ = ag.AddEdge(node1, node2)
+	_ = ag.AddEdge(node2, node3)
+	_ = ag.AddWeightedEdge(node1, node3, 4.5)
+
+	data, err := json.Marshal(ag)
+	s.Require().NoError(err)
+
+	loaded := New()
+	s.Require().NoError(json.Unmarshal(data, loaded))
+
+	s.Require().Equal(ag.ID(), loaded.ID())
+	s.Require().Equal(ag.Name(), loaded.Name())
+	s.Require().Equal(ag.Attrs(), loaded.Attrs())
+	s.Require().True(ag.Equal(loaded))
+	s.Require().Equal(ag.adjacency, loaded.adjacency)
+	s.Require().Equal(ag.backRefs, loaded.backRefs)
+	s.Require().Equal(ag.weights, loaded.weights)
+}
+
+func (s *JSONTestSuite) TestMarshal_Deterministic() {
+	ag := New()
+	_ = ag.AddGroup("test")
+	for i := NodeID(5); i > 0; i-- {
+		_ = ag.AddNode(GroupNode{ID: i, Group: "test"})
+	}
+	_ = ag.AddEdge(GroupNode{ID: 3, Group: "test"}, GroupNode{ID: 1, Group: "test"})
+	_ = ag.AddEdge(GroupNode{ID: 1, Group: "test"}, GroupNode{ID: 2, Group: "test"})
+
+	data, err := json.Marshal(ag)
+	s.Require().NoError(err)
+	s.Require().JSONEq(
+		`{"id":"00000000-0000-0000-0000-000000000000","name":"",`+
+			`"groups":{"test":[1,2,3,4,5]},"edges":[{"from":1,"to":2},{"from":3,"to":1}]}`,
+		string(data),
+	)
+}
+
+func (s *JSONTestSuite) TestMarshal_Attrs() {
+	ag := New()
+	ag.SetAttr("owner", "platform")
+
+	data, err := json.Marshal(ag)
+	s.Require().NoError(err)
+	s.Require().JSONEq(
+		`{"id":"00000000-0000-0000-0000-000000000000","name":"",`+
+			`"attrs":{"owner":"platform"},"groups":{},"edges":[]}`,
+		string(data),
+	)
+
+	loaded := New()
+	s.Require().NoError(json.Unmarshal([]byte(`{"groups":{}}`), loaded))
+	s.Require().NotNil(loaded.Attrs())
+	s.Require().Empty(loaded.Attrs())
+	s.Require().NotPanics(func() { loaded.SetAttr("owner", "platform") })
+}
+
+func (s *JSONTestSuite) TestUnmarshal_EdgeReferencesMissingNode() {
+	ag := New()
+	_ = ag.AddGroup("keep")
+	_ = ag.AddNode(GroupNode{ID: 42, Group: "keep"})
+
+	data := []byte(`{"groups":{"test":[1]},"edges":[{"from":1,"to":2}]}`)
+	err := json.Unmarshal(data, ag)
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().Contains(err.Error(), "target node [2]")
+
+	// receiver must be left untouched
+	s.Require().True(ag.HasNode(GroupNode{ID: 42, Group: "keep"}))
+	s.Require().Equal([]GroupName{"keep"}, ag.ListGroups())
+}
+
+func (s *JSONTestSuite) TestUnmarshal_MalformedInput() {
+	ag := New()
+	err := json.Unmarshal([]byte(`{"groups":[1,2]}`), ag)
+	s.Require().Error(err)
+}
+
+func TestJSONTestSuite(t *testing.T) {
+	suite.Run(t, new(JSONTestSuite))
+}
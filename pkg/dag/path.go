@@ -0,0 +1,203 @@
+package dag
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/barnowlsnest/go-datalib/pkg/list"
+	"github.com/barnowlsnest/go-datalib/pkg/node"
+	"github.com/barnowlsnest/go-datalib/pkg/tree"
+)
+
+// distance is a priority queue entry used by ShortestPath.
+type distance struct {
+	id   NodeID
+	cost float64
+}
+
+// ShortestPath finds the cheapest path from 'from' to 'to' using Dijkstra's
+// algorithm over edge weights. Edges created with AddEdge count as
+// DefaultEdgeWeight, so the method also works on unweighted graphs, where it
+// returns a path with the fewest edges.
+//
+// Returns the path including both endpoints and its total cost. A path from a
+// node to itself is the single node with cost 0.
+//
+// Returns an error if:
+//   - Either node doesn't exist (ErrInvalidAdjacency)
+//   - 'to' is unreachable from 'from' (ErrNoPath)
+//   - A negative edge weight is encountered (ErrInvalidEdge)
+//
+// Time complexity: O((V + E) log V) where V is nodes and E is edges
+// Space complexity: O(V)
+func (g *Graph) ShortestPath(from, to GroupNode) ([]GroupNode, float64, error) {
+	if fromErr := g.checkNodeExists(from); fromErr != nil {
+		return nil, 0, errors.Join(ErrInvalidAdjacency, fromErr)
+	}
+	if toErr := g.checkNodeExists(to); toErr != nil {
+		return nil, 0, errors.Join(ErrInvalidAdjacency, toErr)
+	}
+
+	dist := map[NodeID]float64{from.ID: 0}
+	prev := make(map[NodeID]NodeID)
+	done := make(map[NodeID]struct{})
+	pq := tree.NewHeap(func(a, b distance) bool { return a.cost < b.cost })
+	pq.Push(distance{id: from.ID})
+
+	for !pq.IsEmpty() {
+		cur, _ := pq.Pop()
+		if _, settled := done[cur.id]; settled {
+			continue
+		}
+		done[cur.id] = struct{}{}
+		if cur.id == to.ID {
+			break
+		}
+
+		for next := range g.adjacency[cur.id] {
+			w := g.weight(cur.id, next)
+			if w < 0 {
+				return nil, 0, errors.Join(ErrInvalidEdge, fmt.Errorf("edge [%d -> %d] negative weight %v", cur.id, next, w))
+			}
+			cost := cur.cost + w
+			if known, seen := dist[next]; seen && known <= cost {
+				continue
+			}
+			dist[next] = cost
+			prev[next] = cur.id
+			pq.Push(distance{id: next, cost: cost})
+		}
+	}
+
+	if _, reached := done[to.ID]; !reached {
+		return nil, 0, errors.Join(ErrNoPath, fmt.Errorf("from [%d] to [%d]", from.ID, to.ID))
+	}
+
+	ids := []NodeID{to.ID}
+	for id := to.ID; id != from.ID; {
+		id = prev[id]
+		ids = append(ids, id)
+	}
+	slices.Reverse(ids)
+
+	path := make([]GroupNode, len(ids))
+	path[0], path[len(path)-1] = from, to
+	for i := 1; i < len(ids)-1; i++ {
+		gn, ok := g.resolve(ids[i])
+		if !ok {
+			return nil, 0, errors.Join(ErrNodeNotFound, fmt.Errorf("node [%d]", ids[i]))
+		}
+		path[i] = gn
+	}
+
+	return path, dist[to.ID], nil
+}
+
+// LongestPath finds the critical path of the graph: the path with the most
+// edges. Returns its length in edges and one path witnessing it, including both
+// endpoints. When several paths tie, the one ending at the smallest node ID is
+// returned. A graph without edges has a longest path of length 0 made of its
+// smallest node; an empty graph yields 0 and an empty path.
+//
+// Returns ErrCyclicGraph if the graph contains a cycle.
+//
+// Time complexity: O(V log V + E log E) where V is nodes and E is edges
+// Space complexity: O(V)
+func (g *Graph) LongestPath() (int, []GroupNode, error) {
+	length, path, err := g.longestPath(func(NodeID, NodeID) float64 { return 1 })
+	return int(length), path, err
+}
+
+// LongestWeightedPath is LongestPath with each edge counting as its weight
+// instead of 1. Edges created with AddEdge count as DefaultEdgeWeight, and
+// negative weights are allowed. Returns the total weight of the path and one
+// path witnessing it.
+//
+// Returns ErrCyclicGraph if the graph contains a cycle.
+//
+// Time complexity: O(V log V + E log E) where V is nodes and E is edges
+// Space complexity: O(V)
+func (g *Graph) LongestWeightedPath() (float64, []GroupNode, error) {
+	return g.longestPath(g.weight)
+}
+
+// topologicalOrder orders every node so that each edge points from an earlier
+// node to a later one, using Kahn's algorithm with ties broken by node ID.
+// Returns false if the graph contains a cycle.
+func (g *Graph) topologicalOrder() ([]NodeID, bool) {
+	ids := g.nodeIDs()
+	in := make(map[NodeID]int, len(ids))
+	q := list.NewQueue()
+	for _, id := range ids {
+		in[id] = len(g.backRefs[id])
+		if in[id] == 0 {
+			q.Enqueue(node.ID(id))
+		}
+	}
+
+	order := make([]NodeID, 0, len(ids))
+	for !q.IsEmpty() {
+		n := q.Dequeue()
+		if n == nil {
+			break
+		}
+		order = append(order, n.ID())
+		for _, to := range slices.Sorted(maps.Keys(g.adjacency[n.ID()])) {
+			in[to]--
+			if in[to] == 0 {
+				q.Enqueue(node.ID(to))
+			}
+		}
+	}
+
+	return order, len(order) == len(ids)
+}
+
+// longestPath relaxes every edge in topological order, keeping for each node
+// the heaviest path that ends there and the predecessor on that path.
+func (g *Graph) longestPath(weight func(from, to NodeID) float64) (float64, []GroupNode, error) {
+	order, acyclic := g.topologicalOrder()
+	if !acyclic {
+		return 0, nil, errors.Join(ErrCyclicGraph, fmt.Errorf("graph [%s]", g.name))
+	}
+	if len(order) == 0 {
+		return 0, []GroupNode{}, nil
+	}
+
+	dist := make(map[NodeID]float64, len(order))
+	prev := make(map[NodeID]NodeID)
+	for _, from := range order {
+		for _, to := range slices.Sorted(maps.Keys(g.adjacency[from])) {
+			if cost := dist[from] + weight(from, to); cost > dist[to] {
+				dist[to] = cost
+				prev[to] = from
+			}
+		}
+	}
+
+	end := order[0]
+	for _, id := range order {
+		if dist[id] > dist[end] || dist[id] == dist[end] && id < end {
+			end = id
+		}
+	}
+
+	ids := []NodeID{end}
+	for id, ok := prev[end]; ok; id, ok = prev[id] {
+		ids = append(ids, id)
+	}
+	slices.Reverse(ids)
+
+	path := make([]GroupNode, len(ids))
+	for i, id := range ids {
+		gn, ok := g.resolve(id)
+		if !ok {
+			return 0, nil, errors.Join(ErrNodeNotFound, fmt.Errorf("node [%d]", id))
+		}
+		path[i] = gn
+	}
+
+	return dist[end], path, nil
+}
@@ -0,0 +1,205 @@
+package dag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ShortestPathTestSuite tests Dijkstra shortest paths and DAG longest paths
+type ShortestPathTestSuite struct {
+	suite.Suite
+}
+
+func (s *ShortestPathTestSuite) newGraph(count int) (*Graph, []GroupNode) {
+	ag := New()
+	_ = ag.AddGroup("a")
+	_ = ag.AddGroup("b")
+
+	nodes := make([]GroupNode, count)
+	for i := range nodes {
+		group := "a"
+		if i%2 == 1 {
+			group = "b"
+		}
+		nodes[i] = GroupNode{ID: NodeID(i + 1), Group: group}
+		_ = ag.AddNode(nodes[i])
+	}
+	return ag, nodes
+}
+
+func (s *ShortestPathTestSuite) TestShortestPath_Weighted() {
+	ag, n := s.newGraph(5)
+
+	// direct route 0 -> 4 is expensive, detour through 1, 2, 3 is cheaper
+	_ = ag.AddWeightedEdge(n[0], n[4], 10)
+	_ = ag.AddWeightedEdge(n[0], n[1], 1)
+	_ = ag.AddWeightedEdge(n[1], n[2], 2)
+	_ = ag.AddWeightedEdge(n[2], n[3], 1.5)
+	_ = ag.AddWeightedEdge(n[3], n[4], 0.5)
+	_ = ag.AddWeightedEdge(n[1], n[3], 5)
+
+	path, cost, err := ag.ShortestPath(n[0], n[4])
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{n[0], n[1], n[2], n[3], n[4]}, path)
+	s.Require().InDelta(5.0, cost, 1e-9)
+}
+
+func (s *ShortestPathTestSuite) TestShortestPath_UnweightedDefaultsToOne() {
+	ag, n := s.newGraph(4)
+
+	_ = ag.AddEdge(n[0], n[1])
+	_ = ag.AddEdge(n[1], n[2])
+	_ = ag.AddEdge(n[2], n[3])
+	_ = ag.AddEdge(n[0], n[2])
+
+	path, cost, err := ag.ShortestPath(n[0], n[3])
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{n[0], n[2], n[3]}, path)
+	s.Require().Equal(2.0, cost)
+}
+
+func (s *ShortestPathTestSuite) TestShortestPath_SameNode() {
+	ag, n := s.newGraph(1)
+
+	path, cost, err := ag.ShortestPath(n[0], n[0])
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{n[0]}, path)
+	s.Require().Equal(0.0, cost)
+}
+
+func (s *ShortestPathTestSuite) TestShortestPath_Unreachable() {
+	ag, n := s.newGraph(3)
+	_ = ag.AddEdge(n[0], n[1])
+	_ = ag.AddEdge(n[2], n[0])
+
+	path, cost, err := ag.ShortestPath(n[0], n[2])
+	s.Require().ErrorIs(err, ErrNoPath)
+	s.Require().Nil(path)
+	s.Require().Equal(0.0, cost)
+}
+
+func (s *ShortestPathTestSuite) TestShortestPath_WithCycle() {
+	ag, n := s.newGraph(3)
+	_ = ag.AddEdge(n[0], n[1])
+	_ = ag.AddEdge(n[1], n[0])
+	_ = ag.AddEdge(n[1], n[2])
+
+	path, cost, err := ag.ShortestPath(n[0], n[2])
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{n[0], n[1], n[2]}, path)
+	s.Require().Equal(2.0, cost)
+}
+
+func (s *ShortestPathTestSuite) TestShortestPath_NegativeWeight() {
+	ag, n := s.newGraph(2)
+	_ = ag.AddWeightedEdge(n[0], n[1], -1)
+
+	_, _, err := ag.ShortestPath(n[0], n[1])
+	s.Require().ErrorIs(err, ErrInvalidEdge)
+}
+
+func (s *ShortestPathTestSuite) TestShortestPath_NonExistentNode() {
+	ag, n := s.newGraph(1)
+	missing := GroupNode{ID: 99, Group: "a"}
+
+	_, _, err := ag.ShortestPath(n[0], missing)
+	s.Require().ErrorIs(err, ErrInvalidAdjacency)
+
+	_, _, err = ag.ShortestPath(missing, n[0])
+	s.Require().ErrorIs(err, ErrInvalidAdjacency)
+}
+
+func (s *ShortestPathTestSuite) TestLongestPath() {
+	ag, n := s.newGraph(7)
+
+	// 0 -> 1 -> 2 -> 3 -> 4 is the critical path; 0 -> 4 and 0 -> 5 -> 4 are shortcuts
+	_ = ag.AddEdge(n[0], n[1])
+	_ = ag.AddEdge(n[1], n[2])
+	_ = ag.AddEdge(n[2], n[3])
+	_ = ag.AddEdge(n[3], n[4])
+	_ = ag.AddEdge(n[0], n[4])
+	_ = ag.AddEdge(n[0], n[5])
+	_ = ag.AddEdge(n[5], n[4])
+	// 6 is isolated
+
+	length, path, err := ag.LongestPath()
+	s.Require().NoError(err)
+	s.Require().Equal(4, length)
+	s.Require().Equal([]GroupNode{n[0], n[1], n[2], n[3], n[4]}, path)
+}
+
+func (s *ShortestPathTestSuite) TestLongestPath_IgnoresWeights() {
+	ag, n := s.newGraph(3)
+	_ = ag.AddWeightedEdge(n[0], n[2], 100)
+	_ = ag.AddEdge(n[0], n[1])
+	_ = ag.AddEdge(n[1], n[2])
+
+	length, path, err := ag.LongestPath()
+	s.Require().NoError(err)
+	s.Require().Equal(2, length)
+	s.Require().Equal([]GroupNode{n[0], n[1], n[2]}, path)
+}
+
+func (s *ShortestPathTestSuite) TestLongestPath_NoEdges() {
+	length, path, err := New().LongestPath()
+	s.Require().NoError(err)
+	s.Require().Equal(0, length)
+	s.Require().NotNil(path)
+	s.Require().Empty(path)
+
+	ag, n := s.newGraph(3)
+	length, path, err = ag.LongestPath()
+	s.Require().NoError(err)
+	s.Require().Equal(0, length)
+	s.Require().Equal([]GroupNode{n[0]}, path)
+}
+
+func (s *ShortestPathTestSuite) TestLongestPath_Cyclic() {
+	ag, n := s.newGraph(4)
+	_ = ag.AddEdge(n[0], n[1])
+	_ = ag.AddEdge(n[1], n[2])
+	_ = ag.AddEdge(n[2], n[1])
+
+	length, path, err := ag.LongestPath()
+	s.Require().ErrorIs(err, ErrCyclicGraph)
+	s.Require().Zero(length)
+	s.Require().Nil(path)
+
+	_, _, err = ag.LongestWeightedPath()
+	s.Require().ErrorIs(err, ErrCyclicGraph)
+
+	self, m := s.newGraph(1)
+	_ = self.AddEdge(m[0], m[0])
+	_, _, err = self.LongestPath()
+	s.Require().ErrorIs(err, ErrCyclicGraph)
+}
+
+func (s *ShortestPathTestSuite) TestLongestWeightedPath() {
+	ag, n := s.newGraph(6)
+
+	// task durations on edges: 0 -> 1 -> 3 takes 2 + 3 = 5, 0 -> 2 -> 3 takes 1 + 6 = 7
+	_ = ag.AddWeightedEdge(n[0], n[1], 2)
+	_ = ag.AddWeightedEdge(n[1], n[3], 3)
+	_ = ag.AddWeightedEdge(n[0], n[2], 1)
+	_ = ag.AddWeightedEdge(n[2], n[3], 6)
+	// unweighted edge counts as DefaultEdgeWeight
+	_ = ag.AddEdge(n[3], n[4])
+	// a negative edge never extends the critical path
+	_ = ag.AddWeightedEdge(n[4], n[5], -3)
+
+	total, path, err := ag.LongestWeightedPath()
+	s.Require().NoError(err)
+	s.Require().InDelta(7+DefaultEdgeWeight, total, 1e-9)
+	s.Require().Equal([]GroupNode{n[0], n[2], n[3], n[4]}, path)
+
+	// By edge count the tie between the two branches goes to the first one found.
+	length, path, err := ag.LongestPath()
+	s.Require().NoError(err)
+	s.Require().Equal(4, length)
+	s.Require().Equal([]GroupNode{n[0], n[1], n[3], n[4], n[5]}, path)
+}
+
+func TestShortestPathTestSuite(t *testing.T) {
+	suite.Run(t, new(ShortestPathTestSuite))
+}
@@ -0,0 +1,168 @@
+package dag
+
+import (
+	"sync"
+)
+
+// SyncGraph is a thread-safe wrapper around Graph.
+//
+// Read-only queries acquire a shared read lock while mutations acquire an
+// exclusive write lock, allowing many concurrent readers and occasional writers.
+//
+// Callbacks passed to traversal methods (BFS, DFS, ForEachNeighbour) run while
+// the read lock is held; they must not call mutating methods on the same
+// SyncGraph or they will deadlock.
+type SyncGraph struct {
+	mu sync.RWMutex
+	g  *Graph
+}
+
+// NewSyncGraph wraps g for concurrent use. If g is nil a new empty Graph is created.
+// The caller must not access g directly after wrapping it.
+func NewSyncGraph(g *Graph) *SyncGraph {
+	if g == nil {
+		g = New()
+	}
+	return &SyncGraph{g: g}
+}
+
+// Name returns the graph's name.
+func (sg *SyncGraph) Name() string {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.Name()
+}
+
+// ID returns the graph's unique identifier.
+func (sg *SyncGraph) ID() ID {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.ID()
+}
+
+// AddGroup creates a new group under the write lock. See Graph.AddGroup.
+func (sg *SyncGraph) AddGroup(name GroupName) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.AddGroup(name)
+}
+
+// RemoveGroup removes a group and its nodes under the write lock. See Graph.RemoveGroup.
+func (sg *SyncGraph) RemoveGroup(name GroupName) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.RemoveGroup(name)
+}
+
+// AddNode adds a node under the write lock. See Graph.AddNode.
+func (sg *SyncGraph) AddNode(n GroupNode) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.AddNode(n)
+}
+
+// RemoveNode removes a node and its edges under the write lock. See Graph.RemoveNode.
+func (sg *SyncGraph) RemoveNode(gn GroupNode) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.RemoveNode(gn)
+}
+
+// AddEdge creates a directed edge under the write lock. See Graph.AddEdge.
+func (sg *SyncGraph) AddEdge(from, to GroupNode) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.AddEdge(from, to)
+}
+
+// RemoveEdge deletes a directed edge under the write lock. See Graph.RemoveEdge.
+func (sg *SyncGraph) RemoveEdge(from, to GroupNode) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	return sg.g.RemoveEdge(from, to)
+}
+
+// HasNode reports whether the node exists, under the read lock. See Graph.HasNode.
+func (sg *SyncGraph) HasNode(gn GroupNode) bool {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.HasNode(gn)
+}
+
+// HasEdge reports whether the edge exists, under the read lock. See Graph.HasEdge.
+func (sg *SyncGraph) HasEdge(from, to GroupNode) bool {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.HasEdge(from, to)
+}
+
+// GetNodes returns the nodes of a group under the read lock. See Graph.GetNodes.
+func (sg *SyncGraph) GetNodes(group GroupName) ([]GroupNode, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.GetNodes(group)
+}
+
+// ListGroups returns all group names under the read lock. See Graph.ListGroups.
+func (sg *SyncGraph) ListGroups() []GroupName {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.ListGroups()
+}
+
+// GetBackRefsOf returns the predecessors of a node under the read lock. See Graph.GetBackRefsOf.
+func (sg *SyncGraph) GetBackRefsOf(gn GroupNode) ([]GroupNode, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.GetBackRefsOf(gn)
+}
+
+// GetForwardRefsOf returns the successors of a node under the read lock. See Graph.GetForwardRefsOf.
+func (sg *SyncGraph) GetForwardRefsOf(gn GroupNode) ([]GroupNode, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.GetForwardRefsOf(gn)
+}
+
+// ForEachNeighbour iterates outgoing edges under the read lock. See Graph.ForEachNeighbour.
+func (sg *SyncGraph) ForEachNeighbour(gn GroupNode, fn OnAdjacencyEdgeFn) error {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.ForEachNeighbour(gn, fn)
+}
+
+// BFS performs a breadth-first traversal under the read lock. See Graph.BFS.
+func (sg *SyncGraph) BFS(start GroupNode, visit OnVisitNodeFn) error {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.BFS(start, visit)
+}
+
+// DFS performs a depth-first traversal under the read lock. See Graph.DFS.
+func (sg *SyncGraph) DFS(start GroupNode, visit OnVisitNodeFn) error {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+	return sg.g.DFS(start, visit)
+}
+
+// IsAcyclic performs cycle detection. See Graph.IsAcyclic.
+//
+// The read lock is acquired before the detection goroutine starts and is
+// released as soon as the result is computed, so writers cannot modify the
+// graph while it is being inspected. The returned channel is buffered, hence
+// the lock is never held while waiting for the caller to receive the result.
+func (sg *SyncGraph) IsAcyclic() <-chan bool {
+	ch := make(chan bool, 1)
+
+	sg.mu.RLock()
+	res := sg.g.IsAcyclic()
+
+	go func() {
+		defer close(ch)
+		acyclic := <-res
+		sg.mu.RUnlock()
+		ch <- acyclic
+	}()
+
+	return ch
+}
@@ -0,0 +1,111 @@
+package dag
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// SyncGraphTestSuite tests the thread-safe graph wrapper
+type SyncGraphTestSuite struct {
+	suite.Suite
+}
+
+func (s *SyncGraphTestSuite) TestNewSyncGraph_Nil() {
+	sg := NewSyncGraph(nil)
+	s.Require().NotNil(sg)
+	s.Require().Empty(sg.ListGroups())
+}
+
+func (s *SyncGraphTestSuite) TestBasicOperations() {
+	sg := NewSyncGraph(New())
+
+	s.Require().NoError(sg.AddGroup("test"))
+	s.Require().ErrorIs(sg.AddGroup("test"), ErrGroupAlreadyExists)
+
+	node1 := GroupNode{ID: 1, Group: "test"}
+	node2 := GroupNode{ID: 2, Group: "test"}
+	s.Require().NoError(sg.AddNode(node1))
+	s.Require().NoError(sg.AddNode(node2))
+	s.Require().NoError(sg.AddEdge(node1, node2))
+
+	s.Require().True(sg.HasNode(node1))
+	s.Require().True(sg.HasEdge(node1, node2))
+	s.Require().Equal([]GroupName{"test"}, sg.ListGroups())
+
+	nodes, err := sg.GetNodes("test")
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]GroupNode{node1, node2}, nodes)
+
+	backRefs, err := sg.GetBackRefsOf(node2)
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{node1}, backRefs)
+
+	forwardRefs, err := sg.GetForwardRefsOf(node1)
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{node2}, forwardRefs)
+
+	count := 0
+	s.Require().NoError(sg.ForEachNeighbour(node1, func(AdjacencyEdge, error) { count++ }))
+	s.Require().NoError(sg.BFS(node1, func(GroupNode) bool { count++; return true }))
+	s.Require().NoError(sg.DFS(node1, func(GroupNode) bool { count++; return true }))
+	s.Require().Equal(5, count)
+
+	s.Require().True(<-sg.IsAcyclic())
+
+	s.Require().NoError(sg.RemoveEdge(node1, node2))
+	s.Require().False(sg.HasEdge(node1, node2))
+	s.Require().NoError(sg.RemoveNode(node2))
+	s.Require().False(sg.HasNode(node2))
+	s.Require().NoError(sg.RemoveGroup("test"))
+	s.Require().Empty(sg.ListGroups())
+
+	s.Require().Equal("", sg.Name())
+	s.Require().Equal(ID{}, sg.ID())
+}
+
+func (s *SyncGraphTestSuite) TestConcurrentReadersAndWriters() {
+	sg := NewSyncGraph(nil)
+	s.Require().NoError(sg.AddGroup("test"))
+
+	const writers, readers, perWriter = 4, 8, 50
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			var prev GroupNode
+			for i := 0; i < perWriter; i++ {
+				n := GroupNode{ID: NodeID(w*perWriter + i + 1), Group: "test"}
+				_ = sg.AddNode(n)
+				if i > 0 {
+					_ = sg.AddEdge(prev, n)
+				}
+				prev = n
+			}
+		}(w)
+	}
+	for r := 0; r < readers; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				_, _ = sg.GetNodes("test")
+				_ = sg.HasEdge(GroupNode{ID: 1, Group: "test"}, GroupNode{ID: 2, Group: "test"})
+				<-sg.IsAcyclic()
+			}
+		}()
+	}
+	wg.Wait()
+
+	nodes, err := sg.GetNodes("test")
+	s.Require().NoError(err)
+	s.Require().Len(nodes, writers*perWriter)
+	s.Require().True(<-sg.IsAcyclic())
+}
+
+func TestSyncGraphTestSuite(t *testing.T) {
+	suite.Run(t, new(SyncGraphTestSuite))
+}
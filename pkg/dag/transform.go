@@ -0,0 +1,231 @@
+package dag
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/barnowlsnest/go-datalib/pkg/serial"
+)
+
+// copyGroups returns an independent copy of the graph's group memberships.
+func (g *Graph) copyGroups() map[GroupName]map[NodeID]struct{} {
+	groups := make(map[GroupName]map[NodeID]struct{}, len(g.groups))
+	for name, nodes := range g.groups {
+		groups[name] = maps.Clone(nodes)
+	}
+	return groups
+}
+
+// Clone returns an independent deep copy of the graph, including its id, name,
+// attributes and node values. Mutating the groups, edges, back-references,
+// weights, attributes or node values of the clone never affects the original and
+// vice versa. The values themselves are copied shallowly.
+//
+// Time complexity: O(V + E) where V is nodes and E is edges
+// Space complexity: O(V + E)
+func (g *Graph) Clone() *Graph {
+	c := New()
+	c.id = g.id
+	c.name = g.name
+	c.attrs = maps.Clone(g.attrs)
+	c.values = maps.Clone(g.values)
+	c.groups = g.copyGroups()
+	for from, neighbours := range g.adjacency {
+		c.adjacency[from] = maps.Clone(neighbours)
+	}
+	for to, refs := range g.backRefs {
+		c.backRefs[to] = maps.Clone(refs)
+	}
+	for from, weighted := range g.weights {
+		c.weights[from] = maps.Clone(weighted)
+	}
+	return c
+}
+
+// Equal reports whether g and other are structurally identical by ID: both
+// have the same groups, each group has the same member nodes, and both have
+// the same set of edges as from/to pairs. Graph name, id, attributes, node
+// values, edge IDs and edge weights are not compared. This is ID-based
+// equality, not isomorphism.
+//
+// Time complexity: O(V + E) where V is nodes and E is edges
+func (g *Graph) Equal(other *Graph) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(g.groups) != len(other.groups) {
+		return false
+	}
+	for name, nodes := range g.groups {
+		otherNodes, ok := other.groups[name]
+		if !ok || !maps.Equal(nodes, otherNodes) {
+			return false
+		}
+	}
+
+	if g.EdgeCount() != other.EdgeCount() {
+		return false
+	}
+	for from, neighbours := range g.adjacency {
+		for to := range neighbours {
+			if _, ok := other.adjacency[from][to]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Transpose returns a new graph with the same name, groups, node memberships and
+// node values but with every edge reversed: an edge from A to B becomes an edge
+// from B to A. Edge weights travel with their reversed edges and back-references
+// are rebuilt accordingly. The original graph is left untouched.
+//
+// Time complexity: O(V + E) where V is nodes and E is edges
+// Space complexity: O(V + E)
+func (g *Graph) Transpose() *Graph {
+	t := New()
+	t.name = g.name
+	t.groups = g.copyGroups()
+	t.values = maps.Clone(g.values)
+	for from, neighbours := range g.adjacency {
+		for to, edge := range neighbours {
+			if _, hasNeighbours := t.adjacency[to]; !hasNeighbours {
+				t.adjacency[to] = make(map[NodeID]EdgeID)
+			}
+			if _, hasRefs := t.backRefs[from]; !hasRefs {
+				t.backRefs[from] = make(map[NodeID]struct{})
+			}
+			t.adjacency[to][from] = edge
+			t.backRefs[from][to] = struct{}{}
+		}
+	}
+	for from, weighted := range g.weights {
+		for to, w := range weighted {
+			if _, hasWeights := t.weights[to]; !hasWeights {
+				t.weights[to] = make(map[NodeID]float64)
+			}
+			t.weights[to][from] = w
+		}
+	}
+	return t
+}
+
+// Merge copies every group, node and edge of other into the receiver.
+// Groups and nodes that already exist are kept as they are, so a node ID that
+// appears in the same group of both graphs is treated as the same node. Edges
+// are unioned and back-references are maintained for every added edge; explicit
+// weights and node values from other overwrite the receiver's for the same edge
+// or node. The receiver's id and name are left unchanged.
+// Returns ErrNil if other is nil.
+//
+// Time complexity: O(V + E) of other
+func (g *Graph) Merge(other *Graph) error {
+	if other == nil {
+		return errors.Join(ErrNil, fmt.Errorf("merge into graph [%s]", g.name))
+	}
+	for name, nodes := range other.groups {
+		if _, groupExists := g.groups[name]; !groupExists {
+			g.groups[name] = make(map[NodeID]struct{}, len(nodes))
+		}
+		for id := range nodes {
+			g.groups[name][id] = struct{}{}
+		}
+	}
+	for from, neighbours := range other.adjacency {
+		if _, hasNeighbours := g.adjacency[from]; !hasNeighbours {
+			g.adjacency[from] = make(map[NodeID]EdgeID, len(neighbours))
+		}
+		for to, edge := range neighbours {
+			if _, hasRefs := g.backRefs[to]; !hasRefs {
+				g.backRefs[to] = make(map[NodeID]struct{})
+			}
+			g.adjacency[from][to] = edge
+			g.backRefs[to][from] = struct{}{}
+		}
+	}
+	for from, weighted := range other.weights {
+		if _, hasWeights := g.weights[from]; !hasWeights {
+			g.weights[from] = make(map[NodeID]float64, len(weighted))
+		}
+		maps.Copy(g.weights[from], weighted)
+	}
+	maps.Copy(g.values, other.values)
+	return nil
+}
+
+// RemapIDs relabels every node of the graph with fn(id), rebuilding groups,
+// adjacency, back-references, weights and node values under the new IDs. Edge
+// IDs are recomputed as NSum of the new endpoint IDs. fn is called once per
+// distinct node ID; a nil fn is a no-op.
+//
+// Typical use is offsetting the IDs of one graph before merging it into another:
+//
+//	other.RemapIDs(func(id NodeID) NodeID { return id + 1000 })
+//	g.Merge(other)
+//
+// Returns ErrIDCollision and leaves the graph unchanged if fn maps two distinct
+// node IDs onto the same ID, whether they belong to the same group or not.
+//
+// Time complexity: O(V + E) where V is nodes and E is edges
+func (g *Graph) RemapIDs(fn func(NodeID) NodeID) error {
+	if fn == nil {
+		return nil
+	}
+
+	remap := make(map[NodeID]NodeID)
+	owners := make(map[NodeID]NodeID)
+	for _, id := range g.nodeIDs() {
+		newID := fn(id)
+		if prev, taken := owners[newID]; taken {
+			return errors.Join(ErrIDCollision, fmt.Errorf("nodes [%d] and [%d] both map to [%d]", prev, id, newID))
+		}
+		owners[newID] = id
+		remap[id] = newID
+	}
+
+	groups := make(map[GroupName]map[NodeID]struct{}, len(g.groups))
+	for name, nodes := range g.groups {
+		groups[name] = make(map[NodeID]struct{}, len(nodes))
+		for id := range nodes {
+			groups[name][remap[id]] = struct{}{}
+		}
+	}
+
+	adjacency := make(map[NodeID]map[NodeID]EdgeID, len(g.adjacency))
+	backRefs := make(map[NodeID]map[NodeID]struct{}, len(g.backRefs))
+	for from, neighbours := range g.adjacency {
+		newFrom := remap[from]
+		adjacency[newFrom] = make(map[NodeID]EdgeID, len(neighbours))
+		for to := range neighbours {
+			newTo := remap[to]
+			if _, hasRefs := backRefs[newTo]; !hasRefs {
+				backRefs[newTo] = make(map[NodeID]struct{})
+			}
+			adjacency[newFrom][newTo] = serial.NSum(newFrom, newTo)
+			backRefs[newTo][newFrom] = struct{}{}
+		}
+	}
+
+	weights := make(map[NodeID]map[NodeID]float64, len(g.weights))
+	for from, weighted := range g.weights {
+		weights[remap[from]] = make(map[NodeID]float64, len(weighted))
+		for to, w := range weighted {
+			weights[remap[from]][remap[to]] = w
+		}
+	}
+
+	values := make(map[NodeID]any, len(g.values))
+	for id, v := range g.values {
+		values[remap[id]] = v
+	}
+
+	g.groups = groups
+	g.adjacency = adjacency
+	g.backRefs = backRefs
+	g.weights = weights
+	g.values = values
+	return nil
+}
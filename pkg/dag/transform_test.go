@@ -0,0 +1,374 @@
+package dag
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/barnowlsnest/go-datalib/pkg/serial"
+)
+
+// TransformTestSuite tests operations that derive new graphs from existing ones
+type TransformTestSuite struct {
+	suite.Suite
+}
+
+func (s *TransformTestSuite) newChain() (*Graph, []GroupNode) {
+	ag := New()
+	ag.name = "chain"
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("deploy")
+
+	nodes := []GroupNode{
+		{ID: 1, Group: "build"},
+		{ID: 2, Group: "build"},
+		{ID: 3, Group: "deploy"},
+		{ID: 4, Group: "deploy"},
+	}
+	for _, n := range nodes {
+		_ = ag.AddNode(n)
+	}
+	_ = ag.AddEdge(nodes[0], nodes[1])
+	_ = ag.AddWeightedEdge(nodes[1], nodes[2], 2.5)
+	return ag, nodes
+}
+
+func (s *TransformTestSuite) TestEqual() {
+	ag, nodes := s.newChain()
+	s.Require().True(ag.Equal(ag))
+	s.Require().True(ag.Equal(ag.Clone()))
+	s.Require().False(ag.Equal(nil))
+
+	// Name, id and weights are ignored.
+	other, _ := s.newChain()
+	other.name = "renamed"
+	s.Require().NoError(other.AddWeightedEdge(nodes[1], nodes[2], 99))
+	s.Require().True(ag.Equal(other))
+	s.Require().True(other.Equal(ag))
+
+	s.Run("extra edge", func() {
+		c := ag.Clone()
+		s.Require().NoError(c.AddEdge(nodes[2], nodes[3]))
+		s.Require().False(ag.Equal(c))
+		s.Require().False(c.Equal(ag))
+	})
+
+	s.Run("same edge count, different edges", func() {
+		c := ag.Clone()
+		s.Require().NoError(c.RemoveEdge(nodes[0], nodes[1]))
+		s.Require().NoError(c.AddEdge(nodes[0], nodes[3]))
+		s.Require().Equal(ag.EdgeCount(), c.EdgeCount())
+		s.Require().False(ag.Equal(c))
+	})
+
+	s.Run("removed edge leaves an empty adjacency entry", func() {
+		c := ag.Clone()
+		s.Require().NoError(c.AddEdge(nodes[2], nodes[3]))
+		s.Require().NoError(c.RemoveEdge(nodes[2], nodes[3]))
+		s.Require().True(ag.Equal(c))
+	})
+
+	s.Run("extra empty group", func() {
+		c := ag.Clone()
+		s.Require().NoError(c.AddGroup("test"))
+		s.Require().False(ag.Equal(c))
+		s.Require().False(c.Equal(ag))
+	})
+
+	s.Run("different membership", func() {
+		c := ag.Clone()
+		s.Require().NoError(c.AddNode(GroupNode{ID: 5, Group: "deploy"}))
+		s.Require().False(ag.Equal(c))
+
+		moved := ag.Clone()
+		s.Require().NoError(moved.RemoveNode(nodes[3]))
+		s.Require().NoError(moved.AddNode(GroupNode{ID: 4, Group: "build"}))
+		s.Require().False(ag.Equal(moved))
+	})
+}
+
+func (s *TransformTestSuite) TestNodeValues_CarriedByTransforms() {
+	ag, nodes := s.newChain()
+	_ = ag.SetNodeValue(nodes[0], "compile")
+	_ = ag.SetNodeValue(nodes[2], "ship")
+
+	assertValue := func(g *Graph, gn GroupNode, want any) {
+		v, ok := g.NodeValue(gn)
+		s.Require().True(ok, "node %d", gn.ID)
+		s.Require().Equal(want, v)
+	}
+
+	assertValue(ag.Clone(), nodes[0], "compile")
+	assertValue(ag.Transpose(), nodes[2], "ship")
+
+	other := New()
+	_ = other.AddGroup("deploy")
+	_ = other.AddNode(nodes[2])
+	_ = other.SetNodeValue(nodes[2], "ship v2")
+	merged := ag.Clone()
+	s.Require().NoError(merged.Merge(other))
+	assertValue(merged, nodes[0], "compile")
+	assertValue(merged, nodes[2], "ship v2")
+
+	remapped := ag.Clone()
+	s.Require().NoError(remapped.RemapIDs(func(id NodeID) NodeID { return id + 100 }))
+	assertValue(remapped, GroupNode{ID: 101, Group: "build"}, "compile")
+	assertValue(remapped, GroupNode{ID: 103, Group: "deploy"}, "ship")
+	s.Require().Len(remapped.values, 2)
+}
+
+func (s *TransformTestSuite) TestTranspose_ReversesEdges() {
+	ag, nodes := s.newChain()
+
+	rev := ag.Transpose()
+	s.Require().Equal("chain", rev.Name())
+	for _, n := range nodes {
+		s.Require().True(rev.HasNode(n))
+	}
+
+	s.Require().True(rev.HasEdge(nodes[1], nodes[0]))
+	s.Require().True(rev.HasEdge(nodes[2], nodes[1]))
+	s.Require().False(rev.HasEdge(nodes[0], nodes[1]))
+	s.Require().False(rev.HasEdge(nodes[1], nodes[2]))
+
+	w, err := rev.EdgeWeight(nodes[2], nodes[1])
+	s.Require().NoError(err)
+	s.Require().Equal(2.5, w)
+
+	refs, err := rev.GetBackRefsOf(nodes[0])
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{nodes[1]}, refs)
+}
+
+func (s *TransformTestSuite) TestTranspose_LeavesOriginalUntouched() {
+	ag, nodes := s.newChain()
+
+	rev := ag.Transpose()
+	s.Require().NoError(rev.AddEdge(nodes[3], nodes[0]))
+	s.Require().NoError(rev.RemoveNode(nodes[1]))
+
+	s.Require().True(ag.HasNode(nodes[1]))
+	s.Require().True(ag.HasEdge(nodes[0], nodes[1]))
+	s.Require().True(ag.HasEdge(nodes[1], nodes[2]))
+	s.Require().False(ag.HasEdge(nodes[3], nodes[0]))
+}
+
+func (s *TransformTestSuite) TestTranspose_Twice() {
+	ag, nodes := s.newChain()
+
+	tt := ag.Transpose().Transpose()
+	s.Require().True(tt.HasEdge(nodes[0], nodes[1]))
+	s.Require().True(tt.HasEdge(nodes[1], nodes[2]))
+	s.Require().Equal(ag.adjacency, tt.adjacency)
+	s.Require().Equal(ag.backRefs, tt.backRefs)
+	s.Require().Equal(ag.weights, tt.weights)
+	s.Require().True(ag.Equal(tt))
+	s.Require().False(ag.Equal(ag.Transpose()))
+}
+
+func (s *TransformTestSuite) TestClone_CopiesEverything() {
+	ag, nodes := s.newChain()
+	ag.id = uuid.New()
+
+	c := ag.Clone()
+	s.Require().Equal(ag.ID(), c.ID())
+	s.Require().Equal(ag.Name(), c.Name())
+	s.Require().Equal(ag.groups, c.groups)
+	s.Require().Equal(ag.adjacency, c.adjacency)
+	s.Require().Equal(ag.backRefs, c.backRefs)
+	s.Require().Equal(ag.weights, c.weights)
+
+	w, err := c.EdgeWeight(nodes[1], nodes[2])
+	s.Require().NoError(err)
+	s.Require().Equal(2.5, w)
+}
+
+func (s *TransformTestSuite) TestClone_IsIndependent() {
+	ag, nodes := s.newChain()
+
+	c := ag.Clone()
+	s.Require().NoError(c.AddEdge(nodes[2], nodes[3]))
+	s.Require().True(c.HasEdge(nodes[2], nodes[3]))
+	s.Require().False(ag.HasEdge(nodes[2], nodes[3]))
+
+	s.Require().NoError(c.AddWeightedEdge(nodes[1], nodes[2], 7))
+	w, err := ag.EdgeWeight(nodes[1], nodes[2])
+	s.Require().NoError(err)
+	s.Require().Equal(2.5, w)
+
+	s.Require().NoError(c.RemoveGroup("build"))
+	s.Require().True(ag.HasNode(nodes[0]))
+	s.Require().True(ag.HasEdge(nodes[0], nodes[1]))
+
+	refs, err := ag.GetBackRefsOf(nodes[2])
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{nodes[1]}, refs)
+
+	s.Require().NoError(ag.AddGroup("test"))
+	s.Require().NotContains(c.ListGroups(), "test")
+
+	c3 := ag.Clone()
+	s.Require().NoError(c3.SetNodeValue(nodes[0], "changed"))
+	_, ok := ag.NodeValue(nodes[0])
+	s.Require().False(ok)
+
+	ag.SetAttr("owner", "platform")
+	c2 := ag.Clone()
+	s.Require().Equal(map[string]string{"owner": "platform"}, c2.Attrs())
+	c2.SetAttr("owner", "changed")
+	owner, _ := ag.Attr("owner")
+	s.Require().Equal("platform", owner)
+}
+
+func (s *TransformTestSuite) TestMerge() {
+	ag, nodes := s.newChain()
+
+	other := New()
+	_ = other.AddGroup("build")
+	_ = other.AddGroup("release")
+	shared := GroupNode{ID: 2, Group: "build"}
+	release := GroupNode{ID: 5, Group: "release"}
+	_ = other.AddNode(nodes[0])
+	_ = other.AddNode(shared)
+	_ = other.AddNode(release)
+	_ = other.AddEdge(nodes[0], shared)
+	_ = other.AddWeightedEdge(shared, release, 4)
+
+	s.Require().NoError(ag.Merge(other))
+	s.Require().Equal("chain", ag.Name())
+	s.Require().ElementsMatch([]GroupName{"build", "deploy", "release"}, ag.ListGroups())
+
+	build, err := ag.GetNodes("build")
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]GroupNode{nodes[0], nodes[1]}, build)
+	s.Require().True(ag.HasNode(release))
+
+	// existing and merged edges are unioned
+	s.Require().True(ag.HasEdge(nodes[0], nodes[1]))
+	s.Require().True(ag.HasEdge(nodes[1], nodes[2]))
+	s.Require().True(ag.HasEdge(shared, release))
+
+	refs, err := ag.GetBackRefsOf(nodes[1])
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{nodes[0]}, refs)
+
+	refs, err = ag.GetBackRefsOf(release)
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{shared}, refs)
+
+	w, err := ag.EdgeWeight(shared, release)
+	s.Require().NoError(err)
+	s.Require().Equal(4.0, w)
+
+	out, err := ag.OutDegree(shared)
+	s.Require().NoError(err)
+	s.Require().Equal(2, out)
+
+	// other is left untouched
+	s.Require().False(other.HasNode(nodes[2]))
+	s.Require().NotContains(other.ListGroups(), "deploy")
+}
+
+func (s *TransformTestSuite) TestMerge_Idempotent() {
+	ag, _ := s.newChain()
+	snapshot := ag.Clone()
+
+	s.Require().NoError(ag.Merge(snapshot))
+	s.Require().NoError(ag.Merge(ag.Clone()))
+	s.Require().Equal(snapshot.groups, ag.groups)
+	s.Require().Equal(snapshot.adjacency, ag.adjacency)
+	s.Require().Equal(snapshot.backRefs, ag.backRefs)
+	s.Require().Equal(snapshot.weights, ag.weights)
+}
+
+func (s *TransformTestSuite) TestMerge_Nil() {
+	ag, _ := s.newChain()
+	s.Require().ErrorIs(ag.Merge(nil), ErrNil)
+}
+
+func (s *TransformTestSuite) TestRemapIDs() {
+	ag, nodes := s.newChain()
+
+	calls := 0
+	s.Require().NoError(ag.RemapIDs(func(id NodeID) NodeID {
+		calls++
+		return id + 100
+	}))
+	s.Require().Equal(len(nodes), calls)
+
+	for _, n := range nodes {
+		s.Require().False(ag.HasNode(n))
+		s.Require().True(ag.HasNode(GroupNode{ID: n.ID + 100, Group: n.Group}))
+	}
+
+	b1 := GroupNode{ID: 101, Group: "build"}
+	b2 := GroupNode{ID: 102, Group: "build"}
+	d3 := GroupNode{ID: 103, Group: "deploy"}
+	s.Require().True(ag.HasEdge(b1, b2))
+	s.Require().True(ag.HasEdge(b2, d3))
+	s.Require().Equal(2, ag.EdgeCount())
+
+	edge, err := ag.GetEdge(b2, d3)
+	s.Require().NoError(err)
+	s.Require().Equal(serial.NSum(102, 103), edge)
+
+	w, err := ag.EdgeWeight(b2, d3)
+	s.Require().NoError(err)
+	s.Require().Equal(2.5, w)
+
+	refs, err := ag.GetBackRefsOf(d3)
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{b2}, refs)
+}
+
+func (s *TransformTestSuite) TestRemapIDs_EnablesMerge() {
+	ag, _ := s.newChain()
+	other, _ := s.newChain()
+
+	s.Require().NoError(other.RemapIDs(func(id NodeID) NodeID { return id + 10 }))
+	s.Require().NoError(ag.Merge(other))
+	s.Require().Equal(8, ag.NodeCount())
+	s.Require().Equal(4, ag.EdgeCount())
+}
+
+func (s *TransformTestSuite) TestRemapIDs_Collision() {
+	ag, _ := s.newChain()
+	snapshot := ag.Clone()
+
+	// 1 and 2 share the build group
+	err := ag.RemapIDs(func(id NodeID) NodeID {
+		if id == 2 {
+			return 1
+		}
+		return id
+	})
+	s.Require().ErrorIs(err, ErrIDCollision)
+
+	// 2 (build) and 3 (deploy) live in different groups
+	err = ag.RemapIDs(func(id NodeID) NodeID {
+		if id == 3 {
+			return 12
+		}
+		return id + 10
+	})
+	s.Require().ErrorIs(err, ErrIDCollision)
+
+	s.Require().Equal(snapshot.groups, ag.groups)
+	s.Require().Equal(snapshot.adjacency, ag.adjacency)
+	s.Require().Equal(snapshot.backRefs, ag.backRefs)
+	s.Require().Equal(snapshot.weights, ag.weights)
+}
+
+func (s *TransformTestSuite) TestRemapIDs_Nil() {
+	ag, _ := s.newChain()
+	snapshot := ag.Clone()
+
+	s.Require().NoError(ag.RemapIDs(nil))
+	s.Require().Equal(snapshot.adjacency, ag.adjacency)
+}
+
+func TestTransformTestSuite(t *testing.T) {
+	suite.Run(t, new(TransformTestSuite))
+}
@@ -0,0 +1,208 @@
+package dag
+
+import (
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/barnowlsnest/go-datalib/pkg/list"
+	"github.com/barnowlsnest/go-datalib/pkg/node"
+)
+
+type (
+	// traverser abstracts the frontier used by graph walks so that the same
+	// traversal loop can run depth-first (stack) or breadth-first (queue).
+	traverser interface {
+		add(id NodeID)
+		next() (NodeID, bool)
+		isEmpty() bool
+	}
+
+	stackTraverser struct {
+		stack *list.Stack
+	}
+
+	queueTraverser struct {
+		queue *list.Queue
+	}
+)
+
+func (t *stackTraverser) add(id NodeID) {
+	t.stack.Push(node.ID(id))
+}
+
+func (t *stackTraverser) next() (NodeID, bool) {
+	n := t.stack.Pop()
+	if n == nil {
+		return 0, false
+	}
+	return n.ID(), true
+}
+
+func (t *stackTraverser) isEmpty() bool {
+	return t.stack.IsEmpty()
+}
+
+func (t *queueTraverser) add(id NodeID) {
+	t.queue.Enqueue(node.ID(id))
+}
+
+func (t *queueTraverser) next() (NodeID, bool) {
+	n := t.queue.Dequeue()
+	if n == nil {
+		return 0, false
+	}
+	return n.ID(), true
+}
+
+func (t *queueTraverser) isEmpty() bool {
+	return t.queue.IsEmpty()
+}
+
+// traverse walks every node reachable from start via outgoing edges using the
+// frontier provided by t. Each node is visited at most once; the walk stops as
+// soon as visit returns false.
+func (g *Graph) traverse(start GroupNode, t traverser, visit OnVisitNodeFn) error {
+	if nodeErr := g.checkNodeExists(start); nodeErr != nil {
+		return errors.Join(ErrInvalidAdjacency, nodeErr)
+	}
+	if visit == nil {
+		return nil
+	}
+
+	visited := make(map[NodeID]struct{})
+	t.add(start.ID)
+	for !t.isEmpty() {
+		id, ok := t.next()
+		if !ok {
+			return nil
+		}
+		if _, seen := visited[id]; seen {
+			continue
+		}
+		visited[id] = struct{}{}
+
+		gn := start
+		if id != start.ID {
+			if gn, ok = g.resolve(id); !ok {
+				continue
+			}
+		}
+		if !visit(gn) {
+			return nil
+		}
+
+		for to := range g.adjacency[id] {
+			if _, seen := visited[to]; !seen {
+				t.add(to)
+			}
+		}
+	}
+
+	return nil
+}
+
+// BFS performs a breadth-first traversal over outgoing edges starting at the
+// specified node. The start node is visited first, then its neighbours level by
+// level. Already visited nodes are skipped and the traversal stops early when
+// visit returns false.
+// Returns ErrInvalidAdjacency if the start node doesn't exist.
+//
+// Note: The order of nodes within the same level is non-deterministic due to map iteration.
+func (g *Graph) BFS(start GroupNode, visit OnVisitNodeFn) error {
+	return g.traverse(start, &queueTraverser{queue: list.NewQueue()}, visit)
+}
+
+// DFS performs an iterative depth-first traversal over outgoing edges starting at
+// the specified node. The start node is visited first. Already visited nodes are
+// skipped and the traversal stops early when visit returns false.
+// Returns ErrInvalidAdjacency if the start node doesn't exist.
+//
+// Note: The order of sibling branches is non-deterministic due to map iteration.
+func (g *Graph) DFS(start GroupNode, visit OnVisitNodeFn) error {
+	return g.traverse(start, &stackTraverser{stack: list.NewStack()}, visit)
+}
+
+// IsReachable reports whether 'to' can be reached from 'from' by following
+// outgoing edges. The search is a breadth-first walk that stops as soon as 'to'
+// is found.
+//
+// Identity is treated as trivially reachable: IsReachable(n, n) is true for
+// every existing node, whether or not it has a self-loop.
+// Returns ErrInvalidAdjacency if either node doesn't exist.
+func (g *Graph) IsReachable(from, to GroupNode) (bool, error) {
+	if toErr := g.checkNodeExists(to); toErr != nil {
+		return false, errors.Join(ErrInvalidAdjacency, toErr)
+	}
+
+	var found bool
+	err := g.BFS(from, func(gn GroupNode) bool {
+		found = gn.ID == to.ID
+		return !found
+	})
+	if err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// reachableFrom returns every node that can be reached from the specified node
+// by following one or more outgoing edges. The node itself is only included
+// when it lies on a cycle.
+func (g *Graph) reachableFrom(from NodeID) map[NodeID]struct{} {
+	reached := make(map[NodeID]struct{})
+	stack := list.NewStack()
+	for to := range g.adjacency[from] {
+		stack.Push(node.ID(to))
+	}
+	for !stack.IsEmpty() {
+		id := stack.Pop().ID()
+		if _, seen := reached[id]; seen {
+			continue
+		}
+		reached[id] = struct{}{}
+		for to := range g.adjacency[id] {
+			if _, seen := reached[to]; !seen {
+				stack.Push(node.ID(to))
+			}
+		}
+	}
+	return reached
+}
+
+// TransitiveClosure computes, for every node in the graph, the set of nodes
+// reachable from it by following one or more outgoing edges. Every node has an
+// entry, which is empty for nodes without outgoing edges. A node appears in its
+// own set only when it lies on a cycle.
+//
+// The closure is built with one depth-first search per node.
+//
+// Time complexity: O(V·(V + E)) where V is nodes and E is edges
+// Space complexity: O(V²) in the worst case
+func (g *Graph) TransitiveClosure() map[NodeID]map[NodeID]struct{} {
+	ids := g.nodeIDs()
+	closure := make(map[NodeID]map[NodeID]struct{}, len(ids))
+	for _, id := range ids {
+		closure[id] = g.reachableFrom(id)
+	}
+	return closure
+}
+
+// ReachableFrom returns every node that can be reached from the specified node
+// by following one or more outgoing edges, sorted ascending by ID. The node
+// itself is only included when it lies on a cycle.
+// Returns ErrInvalidAdjacency if the node doesn't exist.
+func (g *Graph) ReachableFrom(gn GroupNode) ([]GroupNode, error) {
+	if nodeErr := g.checkNodeExists(gn); nodeErr != nil {
+		return nil, errors.Join(ErrInvalidAdjacency, nodeErr)
+	}
+
+	ids := slices.Sorted(maps.Keys(g.reachableFrom(gn.ID)))
+	res := make([]GroupNode, 0, len(ids))
+	for _, id := range ids {
+		if ref, ok := g.resolve(id); ok {
+			res = append(res, ref)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,305 @@
+package dag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TraversalTestSuite tests BFS and DFS traversals
+type TraversalTestSuite struct {
+	suite.Suite
+}
+
+// buildDiamond creates the following graph across two groups:
+//
+//	1 -> 2 -> 4
+//	1 -> 3 -> 4
+//	4 -> 5
+//
+// Node 6 is isolated.
+func (s *TraversalTestSuite) buildDiamond() (*Graph, map[NodeID]GroupNode) {
+	ag := New()
+	s.Require().NoError(ag.AddGroup("build"))
+	s.Require().NoError(ag.AddGroup("test"))
+
+	nodes := map[NodeID]GroupNode{
+		1: {ID: 1, Group: "build"},
+		2: {ID: 2, Group: "build"},
+		3: {ID: 3, Group: "build"},
+		4: {ID: 4, Group: "test"},
+		5: {ID: 5, Group: "test"},
+		6: {ID: 6, Group: "test"},
+	}
+	for _, n := range nodes {
+		s.Require().NoError(ag.AddNode(n))
+	}
+
+	s.Require().NoError(ag.AddEdge(nodes[1], nodes[2]))
+	s.Require().NoError(ag.AddEdge(nodes[1], nodes[3]))
+	s.Require().NoError(ag.AddEdge(nodes[2], nodes[4]))
+	s.Require().NoError(ag.AddEdge(nodes[3], nodes[4]))
+	s.Require().NoError(ag.AddEdge(nodes[4], nodes[5]))
+
+	return ag, nodes
+}
+
+func (s *TraversalTestSuite) TestBFS_VisitsReachableNodesOnce() {
+	ag, nodes := s.buildDiamond()
+
+	visited := make([]GroupNode, 0)
+	err := ag.BFS(nodes[1], func(gn GroupNode) bool {
+		visited = append(visited, gn)
+		return true
+	})
+
+	s.Require().NoError(err)
+	s.Require().Len(visited, 5)
+	s.Require().Equal(nodes[1], visited[0])
+	s.Require().ElementsMatch([]GroupNode{nodes[2], nodes[3]}, visited[1:3])
+	s.Require().Equal(nodes[4], visited[3])
+	s.Require().Equal(nodes[5], visited[4])
+	s.Require().NotContains(visited, nodes[6])
+}
+
+func (s *TraversalTestSuite) TestDFS_VisitsReachableNodesOnce() {
+	ag, nodes := s.buildDiamond()
+
+	visited := make([]GroupNode, 0)
+	err := ag.DFS(nodes[1], func(gn GroupNode) bool {
+		visited = append(visited, gn)
+		return true
+	})
+
+	s.Require().NoError(err)
+	s.Require().Len(visited, 5)
+	s.Require().Equal(nodes[1], visited[0])
+	s.Require().ElementsMatch(
+		[]GroupNode{nodes[1], nodes[2], nodes[3], nodes[4], nodes[5]},
+		visited,
+	)
+}
+
+func (s *TraversalTestSuite) TestDFS_FollowsBranchBeforeSibling() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	root := GroupNode{ID: 1, Group: "test"}
+	left := GroupNode{ID: 2, Group: "test"}
+	leftChild := GroupNode{ID: 3, Group: "test"}
+	right := GroupNode{ID: 4, Group: "test"}
+	rightChild := GroupNode{ID: 5, Group: "test"}
+	for _, n := range []GroupNode{root, left, leftChild, right, rightChild} {
+		_ = ag.AddNode(n)
+	}
+	_ = ag.AddEdge(root, left)
+	_ = ag.AddEdge(left, leftChild)
+	_ = ag.AddEdge(root, right)
+	_ = ag.AddEdge(right, rightChild)
+
+	visited := make([]GroupNode, 0)
+	err := ag.DFS(root, func(gn GroupNode) bool {
+		visited = append(visited, gn)
+		return true
+	})
+
+	s.Require().NoError(err)
+	s.Require().Len(visited, 5)
+	// Whichever branch is taken first must be fully explored before the other one
+	if visited[1] == left {
+		s.Require().Equal(leftChild, visited[2])
+	} else {
+		s.Require().Equal(right, visited[1])
+		s.Require().Equal(rightChild, visited[2])
+	}
+}
+
+func (s *TraversalTestSuite) TestTraversal_EarlyStop() {
+	ag, nodes := s.buildDiamond()
+
+	for name, walk := range map[string]func(GroupNode, OnVisitNodeFn) error{
+		"BFS": ag.BFS,
+		"DFS": ag.DFS,
+	} {
+		count := 0
+		err := walk(nodes[1], func(GroupNode) bool {
+			count++
+			return count < 2
+		})
+		s.Require().NoError(err, name)
+		s.Require().Equal(2, count, name)
+	}
+}
+
+func (s *TraversalTestSuite) TestTraversal_Cycle() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "test"}
+	node2 := GroupNode{ID: 2, Group: "test"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	_ = ag.AddNode(node1)
+	_ = ag.AddNode(node2)
+	_ = ag.AddNode(node3)
+	_ = ag.AddEdge(node1, node2)
+	_ = ag.AddEdge(node2, node3)
+	_ = ag.AddEdge(node3, node1)
+
+	count := 0
+	err := ag.DFS(node2, func(GroupNode) bool {
+		count++
+		return true
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(3, count)
+}
+
+func (s *TraversalTestSuite) TestTraversal_NonExistentStart() {
+	ag, _ := s.buildDiamond()
+	missing := GroupNode{ID: 99, Group: "build"}
+
+	err := ag.BFS(missing, func(GroupNode) bool { return true })
+	s.Require().ErrorIs(err, ErrInvalidAdjacency)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+
+	err = ag.DFS(GroupNode{ID: 1, Group: "missing"}, func(GroupNode) bool { return true })
+	s.Require().ErrorIs(err, ErrInvalidAdjacency)
+	s.Require().ErrorIs(err, ErrGroupNotFound)
+}
+
+func (s *TraversalTestSuite) TestTraversal_IsolatedNode() {
+	ag, nodes := s.buildDiamond()
+
+	visited := make([]GroupNode, 0)
+	err := ag.BFS(nodes[6], func(gn GroupNode) bool {
+		visited = append(visited, gn)
+		return true
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{nodes[6]}, visited)
+}
+
+func (s *TraversalTestSuite) TestIsReachable() {
+	ag := New()
+	_ = ag.AddGroup("build")
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "build"}
+	node2 := GroupNode{ID: 2, Group: "build"}
+	node3 := GroupNode{ID: 3, Group: "test"}
+	node4 := GroupNode{ID: 4, Group: "test"}
+	_ = ag.AddNode(node1)
+	_ = ag.AddNode(node2)
+	_ = ag.AddNode(node3)
+	_ = ag.AddNode(node4)
+
+	_ = ag.AddEdge(node1, node2)
+	_ = ag.AddEdge(node2, node3)
+
+	cases := []struct {
+		from, to  GroupNode
+		reachable bool
+	}{
+		{node1, node2, true},
+		{node1, node3, true},
+		{node3, node1, false},
+		{node1, node4, false},
+		{node4, node3, false},
+	}
+	for _, c := range cases {
+		reachable, err := ag.IsReachable(c.from, c.to)
+		s.Require().NoError(err)
+		s.Require().Equal(c.reachable, reachable, "%d -> %d", c.from.ID, c.to.ID)
+	}
+}
+
+func (s *TraversalTestSuite) TestIsReachable_Identity() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	isolated := GroupNode{ID: 1, Group: "test"}
+	looped := GroupNode{ID: 2, Group: "test"}
+	_ = ag.AddNode(isolated)
+	_ = ag.AddNode(looped)
+	_ = ag.AddEdge(looped, looped)
+
+	// identity is trivially reachable without a self-loop
+	reachable, err := ag.IsReachable(isolated, isolated)
+	s.Require().NoError(err)
+	s.Require().True(reachable)
+
+	// and still reachable with one
+	reachable, err = ag.IsReachable(looped, looped)
+	s.Require().NoError(err)
+	s.Require().True(reachable)
+}
+
+func (s *TraversalTestSuite) TestIsReachable_NonExistentNode() {
+	ag := New()
+	_ = ag.AddGroup("test")
+
+	node1 := GroupNode{ID: 1, Group: "test"}
+	_ = ag.AddNode(node1)
+
+	reachable, err := ag.IsReachable(node1, GroupNode{ID: 2, Group: "test"})
+	s.Require().ErrorIs(err, ErrInvalidAdjacency)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.Require().False(reachable)
+
+	reachable, err = ag.IsReachable(GroupNode{ID: 2, Group: "missing"}, node1)
+	s.Require().ErrorIs(err, ErrInvalidAdjacency)
+	s.Require().ErrorIs(err, ErrGroupNotFound)
+	s.Require().False(reachable)
+}
+
+func (s *TraversalTestSuite) TestTransitiveClosure() {
+	ag, nodes := s.buildDiamond()
+
+	closure := ag.TransitiveClosure()
+	s.Require().Len(closure, len(nodes))
+
+	set := func(ids ...NodeID) map[NodeID]struct{} {
+		res := make(map[NodeID]struct{}, len(ids))
+		for _, id := range ids {
+			res[id] = struct{}{}
+		}
+		return res
+	}
+	s.Require().Equal(set(2, 3, 4, 5), closure[1])
+	s.Require().Equal(set(4, 5), closure[2])
+	s.Require().Equal(set(4, 5), closure[3])
+	s.Require().Equal(set(5), closure[4])
+	s.Require().Equal(set(), closure[5])
+	s.Require().Equal(set(), closure[6])
+
+	// a cycle makes every node on it reach itself
+	s.Require().NoError(ag.AddEdge(nodes[5], nodes[2]))
+	closure = ag.TransitiveClosure()
+	s.Require().Equal(set(2, 4, 5), closure[2])
+	s.Require().Equal(set(2, 3, 4, 5), closure[1])
+	s.Require().Equal(set(2, 4, 5), closure[3])
+}
+
+func (s *TraversalTestSuite) TestTransitiveClosure_EmptyGraph() {
+	s.Require().Empty(New().TransitiveClosure())
+}
+
+func (s *TraversalTestSuite) TestReachableFrom() {
+	ag, nodes := s.buildDiamond()
+
+	reached, err := ag.ReachableFrom(nodes[1])
+	s.Require().NoError(err)
+	s.Require().Equal([]GroupNode{nodes[2], nodes[3], nodes[4], nodes[5]}, reached)
+
+	reached, err = ag.ReachableFrom(nodes[6])
+	s.Require().NoError(err)
+	s.Require().Empty(reached)
+
+	_, err = ag.ReachableFrom(GroupNode{ID: 7, Group: "test"})
+	s.Require().ErrorIs(err, ErrInvalidAdjacency)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+}
+
+func TestTraversalTestSuite(t *testing.T) {
+	suite.Run(t, new(TraversalTestSuite))
+}
@@ -4,6 +4,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// DefaultEdgeWeight is the weight reported for edges created without an explicit weight.
+const DefaultEdgeWeight = 1.0
+
 type (
 	// NodeID represents a unique identifier for nodes in the graph.
 	// It's an alias for uint64 to provide type safety and clarity.
@@ -54,6 +57,18 @@ type (
 		Edge EdgeID
 	}
 
+	// GroupEdge represents a directed edge between two grouped nodes.
+	//
+	// Unlike AdjacencyEdge, both endpoints carry their group so the edge can
+	// be validated against the graph before it is added.
+	GroupEdge struct {
+		// From is the source node of the edge.
+		From GroupNode
+
+		// To is the destination node of the edge.
+		To GroupNode
+	}
+
 	// BackRefEdge represents a reverse reference edge for efficient traversal.
 	//
 	// Back-references allow for efficient reverse traversal of the graph
@@ -78,4 +93,16 @@ type (
 	//   - AdjacencyEdge: The edge being processed
 	//   - error: Any error that occurred during edge processing, or nil
 	OnAdjacencyEdgeFn func(AdjacencyEdge, error)
+
+	// OnVisitNodeFn is a callback function type for graph traversals.
+	//
+	// It is invoked once for every node reached during a traversal such as
+	// BFS or DFS. Returning false stops the traversal early.
+	//
+	// Parameters:
+	//   - GroupNode: The node being visited, resolved to its group
+	//
+	// Returns:
+	//   - true to continue the traversal, false to stop
+	OnVisitNodeFn func(GroupNode) bool
 )
@@ -0,0 +1,126 @@
+package list
+
+import (
+	"context"
+	"sync"
+)
+
+// ConcurrentQueue implements a FIFO (First In, First Out) queue that is safe
+// for use by multiple goroutines.
+//
+// Elements are stored in a Deque guarded by a mutex. A condition variable
+// wakes consumers blocked in DequeueWait whenever an element is enqueued, so
+// the queue can connect producers and consumers in a pipeline without polling.
+//
+// Key features:
+//   - O(1) amortized enqueue and O(1) dequeue
+//   - Non-blocking Dequeue and context-aware blocking DequeueWait
+//   - Automatic size tracking
+//
+// Thread Safety:
+// All methods are safe for concurrent use.
+type ConcurrentQueue[T any] struct {
+	// mu guards items.
+	mu sync.Mutex
+
+	// notEmpty is signalled when an element is enqueued and broadcast when
+	// a waiting consumer's context is cancelled.
+	notEmpty *sync.Cond
+
+	// items holds the queued elements, front first.
+	items *Deque[T]
+}
+
+// NewConcurrentQueue creates a new empty ConcurrentQueue.
+//
+// Returns:
+//   - A new empty ConcurrentQueue instance
+//
+// Example:
+//
+//	q := NewConcurrentQueue[int]()
+//	go q.Enqueue(1)
+//	v, err := q.DequeueWait(ctx) // 1, nil
+func NewConcurrentQueue[T any]() *ConcurrentQueue[T] {
+	q := &ConcurrentQueue[T]{items: NewDeque[T]()}
+	q.notEmpty = sync.NewCond(&q.mu)
+	return q
+}
+
+// Enqueue adds an element to the rear of the queue and wakes one consumer
+// blocked in DequeueWait, if any.
+//
+// Parameters:
+//   - v: The value to add
+func (q *ConcurrentQueue[T]) Enqueue(v T) {
+	q.mu.Lock()
+	q.items.PushBack(v)
+	q.mu.Unlock()
+
+	q.notEmpty.Signal()
+}
+
+// Dequeue removes and returns the element at the front of the queue without
+// blocking.
+//
+// Returns:
+//   - The front element and true, or the zero value and false if the queue is empty
+func (q *ConcurrentQueue[T]) Dequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.PopFront()
+}
+
+// DequeueWait removes and returns the element at the front of the queue,
+// blocking until one is available or ctx is done.
+//
+// If an element is already queued it is returned even when ctx is done.
+//
+// Parameters:
+//   - ctx: Context controlling how long to wait
+//
+// Returns:
+//   - The front element and nil on success
+//   - The zero value and ctx.Err() if ctx is done before an element arrives
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	v, err := q.DequeueWait(ctx)
+//	if err != nil {
+//		// timed out waiting for a producer
+//	}
+func (q *ConcurrentQueue[T]) DequeueWait(ctx context.Context) (T, error) {
+	// sync.Cond cannot select on ctx.Done, so wake every waiter on
+	// cancellation and let each one re-check its own context.
+	stop := context.AfterFunc(ctx, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.notEmpty.Broadcast()
+	})
+	defer stop()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.items.IsEmpty() {
+		if err := ctx.Err(); err != nil {
+			var zero T
+			return zero, err
+		}
+		q.notEmpty.Wait()
+	}
+
+	v, _ := q.items.PopFront()
+	return v, nil
+}
+
+// Len returns the current number of elements in the queue.
+func (q *ConcurrentQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.Size()
+}
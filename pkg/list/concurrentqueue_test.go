@@ -0,0 +1,166 @@
+package list
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConcurrentQueue(t *testing.T) {
+	t.Run("should create empty queue", func(t *testing.T) {
+		q := NewConcurrentQueue[int]()
+
+		assert.NotNil(t, q)
+		assert.Equal(t, 0, q.Len())
+
+		v, ok := q.Dequeue()
+		assert.False(t, ok)
+		assert.Zero(t, v)
+	})
+}
+
+func TestConcurrentQueueFIFO(t *testing.T) {
+	t.Run("should dequeue in insertion order", func(t *testing.T) {
+		q := NewConcurrentQueue[string]()
+		q.Enqueue("a")
+		q.Enqueue("b")
+		q.Enqueue("c")
+		assert.Equal(t, 3, q.Len())
+
+		for _, want := range []string{"a", "b", "c"} {
+			v, ok := q.Dequeue()
+			assert.True(t, ok)
+			assert.Equal(t, want, v)
+		}
+		assert.Equal(t, 0, q.Len())
+	})
+}
+
+func TestConcurrentQueueDequeueWait(t *testing.T) {
+	t.Run("should return a queued element immediately", func(t *testing.T) {
+		q := NewConcurrentQueue[int]()
+		q.Enqueue(7)
+
+		v, err := q.DequeueWait(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 7, v)
+	})
+
+	t.Run("should block until an element is enqueued", func(t *testing.T) {
+		q := NewConcurrentQueue[int]()
+		done := make(chan int)
+		go func() {
+			v, err := q.DequeueWait(context.Background())
+			assert.NoError(t, err)
+			done <- v
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("DequeueWait returned before an element was enqueued")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		q.Enqueue(42)
+		select {
+		case v := <-done:
+			assert.Equal(t, 42, v)
+		case <-time.After(time.Second):
+			t.Fatal("DequeueWait did not wake up after Enqueue")
+		}
+	})
+
+	t.Run("should return the context error when cancelled", func(t *testing.T) {
+		q := NewConcurrentQueue[int]()
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error)
+		go func() {
+			_, err := q.DequeueWait(ctx)
+			errCh <- err
+		}()
+
+		cancel()
+		select {
+		case err := <-errCh:
+			assert.ErrorIs(t, err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Fatal("DequeueWait did not return after cancellation")
+		}
+	})
+
+	t.Run("should return the deadline error on timeout", func(t *testing.T) {
+		q := NewConcurrentQueue[int]()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		v, err := q.DequeueWait(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Zero(t, v)
+	})
+
+	t.Run("should prefer a queued element over a done context", func(t *testing.T) {
+		q := NewConcurrentQueue[int]()
+		q.Enqueue(1)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		v, err := q.DequeueWait(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, v)
+	})
+}
+
+func TestConcurrentQueueProducerConsumer(t *testing.T) {
+	const producers, perProducer = 4, 500
+
+	q := NewConcurrentQueue[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+
+	results := make(chan int, producers*perProducer)
+	var consumers sync.WaitGroup
+	for c := 0; c < 3; c++ {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				v, err := q.DequeueWait(ctx)
+				if err != nil {
+					return
+				}
+				results <- v
+			}
+		}()
+	}
+
+	seen := make(map[int]bool, producers*perProducer)
+	for len(seen) < producers*perProducer {
+		select {
+		case v := <-results:
+			require.False(t, seen[v], "value %d dequeued twice", v)
+			seen[v] = true
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d of %d values", len(seen), producers*perProducer)
+		}
+	}
+
+	wg.Wait()
+	cancel()
+	consumers.Wait()
+	assert.Equal(t, 0, q.Len())
+}
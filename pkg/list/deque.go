@@ -0,0 +1,161 @@
+package list
+
+// dequeMinCapacity is the capacity allocated on the first push.
+const dequeMinCapacity = 8
+
+// Deque implements a double-ended queue with O(1) operations at both ends.
+//
+// This implementation stores elements in a ring buffer that doubles in size
+// when full, so pushes are amortized O(1) and pops never allocate.
+//
+// Key features:
+//   - Amortized O(1) push at both the front and the back
+//   - O(1) pop and peek at both ends
+//   - Automatic size tracking
+//   - Safe handling of empty deque conditions
+//
+// Thread Safety:
+// Deque is not thread-safe. Concurrent access requires external
+// synchronization mechanisms.
+type Deque[T any] struct {
+	// buf is the ring buffer; its length is always zero or a power of two.
+	buf []T
+
+	// head is the index of the front element in buf.
+	head int
+
+	// size is the number of elements currently stored.
+	size int
+}
+
+// NewDeque creates a new empty Deque.
+//
+// Returns:
+//   - A new empty Deque instance
+//
+// Example:
+//
+//	d := NewDeque[int]()
+//	d.PushBack(1)
+//	d.PushFront(0)
+//	v, _ := d.PopBack() // 1
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// PushFront adds an element to the front of the deque.
+//
+// Parameters:
+//   - v: The value to add
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = d.wrap(d.head - 1)
+	d.buf[d.head] = v
+	d.size++
+}
+
+// PushBack adds an element to the back of the deque.
+//
+// Parameters:
+//   - v: The value to add
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[d.wrap(d.head+d.size)] = v
+	d.size++
+}
+
+// PopFront removes and returns the element at the front of the deque.
+//
+// Returns:
+//   - The front element and true, or the zero value and false if the deque is empty
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	v := d.buf[d.head]
+	d.buf[d.head] = zero // release the reference for GC
+	d.head = d.wrap(d.head + 1)
+	d.size--
+
+	return v, true
+}
+
+// PopBack removes and returns the element at the back of the deque.
+//
+// Returns:
+//   - The back element and true, or the zero value and false if the deque is empty
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	tail := d.wrap(d.head + d.size - 1)
+	v := d.buf[tail]
+	d.buf[tail] = zero // release the reference for GC
+	d.size--
+
+	return v, true
+}
+
+// PeekFront returns the element at the front of the deque without removing it.
+//
+// Returns:
+//   - The front element and true, or the zero value and false if the deque is empty
+func (d *Deque[T]) PeekFront() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// PeekBack returns the element at the back of the deque without removing it.
+//
+// Returns:
+//   - The back element and true, or the zero value and false if the deque is empty
+func (d *Deque[T]) PeekBack() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.wrap(d.head+d.size-1)], true
+}
+
+// Size returns the current number of elements in the deque.
+//
+// Returns:
+//   - The current number of elements in the deque
+func (d *Deque[T]) Size() int {
+	return d.size
+}
+
+// IsEmpty returns true if the deque contains no elements.
+//
+// Returns:
+//   - true if the deque is empty, false otherwise
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// wrap maps i onto a valid buffer index. It relies on len(buf) being a power of two.
+func (d *Deque[T]) wrap(i int) int {
+	return i & (len(d.buf) - 1)
+}
+
+// grow doubles the buffer when it is full, unrolling the ring so the front
+// element lands at index 0.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+
+	buf := make([]T, max(len(d.buf)*2, dequeMinCapacity))
+	n := copy(buf, d.buf[d.head:])
+	copy(buf[n:], d.buf[:d.head])
+
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,169 @@
+package list
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeque(t *testing.T) {
+	t.Run("should create empty deque", func(t *testing.T) {
+		d := NewDeque[int]()
+
+		assert.NotNil(t, d)
+		assert.Equal(t, 0, d.Size())
+		assert.True(t, d.IsEmpty())
+	})
+}
+
+func TestDequePushPop(t *testing.T) {
+	t.Run("should behave as a queue with PushBack and PopFront", func(t *testing.T) {
+		d := NewDeque[int]()
+		for i := 1; i <= 3; i++ {
+			d.PushBack(i)
+		}
+
+		for i := 1; i <= 3; i++ {
+			v, ok := d.PopFront()
+			assert.True(t, ok)
+			assert.Equal(t, i, v)
+		}
+		assert.True(t, d.IsEmpty())
+	})
+
+	t.Run("should behave as a stack with PushFront and PopFront", func(t *testing.T) {
+		d := NewDeque[string]()
+		d.PushFront("a")
+		d.PushFront("b")
+		d.PushFront("c")
+
+		for _, expected := range []string{"c", "b", "a"} {
+			v, ok := d.PopFront()
+			assert.True(t, ok)
+			assert.Equal(t, expected, v)
+		}
+	})
+
+	t.Run("should mix both ends", func(t *testing.T) {
+		d := NewDeque[int]()
+		d.PushBack(2)
+		d.PushFront(1)
+		d.PushBack(3)
+		d.PushFront(0)
+
+		assert.Equal(t, 4, d.Size())
+
+		v, _ := d.PopBack()
+		assert.Equal(t, 3, v)
+		v, _ = d.PopFront()
+		assert.Equal(t, 0, v)
+		v, _ = d.PopBack()
+		assert.Equal(t, 2, v)
+		v, _ = d.PopBack()
+		assert.Equal(t, 1, v)
+		assert.True(t, d.IsEmpty())
+	})
+
+	t.Run("should return zero value and false when empty", func(t *testing.T) {
+		d := NewDeque[*int]()
+
+		v, ok := d.PopFront()
+		assert.False(t, ok)
+		assert.Nil(t, v)
+
+		v, ok = d.PopBack()
+		assert.False(t, ok)
+		assert.Nil(t, v)
+	})
+}
+
+func TestDequePeek(t *testing.T) {
+	t.Run("should peek both ends without removing", func(t *testing.T) {
+		d := NewDeque[int]()
+		d.PushBack(1)
+		d.PushBack(2)
+
+		front, ok := d.PeekFront()
+		assert.True(t, ok)
+		assert.Equal(t, 1, front)
+
+		back, ok := d.PeekBack()
+		assert.True(t, ok)
+		assert.Equal(t, 2, back)
+
+		assert.Equal(t, 2, d.Size())
+	})
+
+	t.Run("should return zero value and false when empty", func(t *testing.T) {
+		d := NewDeque[int]()
+
+		v, ok := d.PeekFront()
+		assert.False(t, ok)
+		assert.Zero(t, v)
+
+		v, ok = d.PeekBack()
+		assert.False(t, ok)
+		assert.Zero(t, v)
+	})
+}
+
+func TestDequeGrowsAcrossWrap(t *testing.T) {
+	d := NewDeque[int]()
+
+	// Offset the head so the ring wraps before growing.
+	for i := 0; i < 5; i++ {
+		d.PushBack(i)
+	}
+	for i := 0; i < 5; i++ {
+		d.PopFront()
+	}
+
+	for i := 0; i < 100; i++ {
+		d.PushBack(i)
+	}
+	for i := -1; i >= -100; i-- {
+		d.PushFront(i)
+	}
+
+	require.Equal(t, 200, d.Size())
+	for i := -100; i < 100; i++ {
+		v, ok := d.PopFront()
+		require.True(t, ok)
+		require.Equal(t, i, v)
+	}
+	assert.True(t, d.IsEmpty())
+}
+
+func TestDequeMatchesSlice(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	d := NewDeque[int]()
+	var ref []int
+
+	for i := 0; i < 10000; i++ {
+		switch rng.IntN(4) {
+		case 0:
+			d.PushFront(i)
+			ref = append([]int{i}, ref...)
+		case 1:
+			d.PushBack(i)
+			ref = append(ref, i)
+		case 2:
+			v, ok := d.PopFront()
+			require.Equal(t, len(ref) > 0, ok)
+			if ok {
+				require.Equal(t, ref[0], v)
+				ref = ref[1:]
+			}
+		case 3:
+			v, ok := d.PopBack()
+			require.Equal(t, len(ref) > 0, ok)
+			if ok {
+				require.Equal(t, ref[len(ref)-1], v)
+				ref = ref[:len(ref)-1]
+			}
+		}
+		require.Equal(t, len(ref), d.Size())
+	}
+}
@@ -0,0 +1,138 @@
+package list
+
+// pqItem pairs a queued value with its priority.
+type pqItem[T any] struct {
+	value    T
+	priority float64
+}
+
+// PriorityQueue implements a min-priority queue over arbitrary values.
+//
+// Items are kept in a binary heap stored in a single slice, so the queue
+// allocates only when that slice grows. The item with the lowest priority
+// is always at the front; ties are returned in no particular order.
+//
+// Key features:
+//   - O(log n) push and pop operations
+//   - O(1) peek at the lowest-priority item
+//   - No per-item allocations
+//
+// Thread Safety:
+// PriorityQueue is not thread-safe. Concurrent access requires external
+// synchronization mechanisms.
+type PriorityQueue[T any] struct {
+	// items holds the heap in level order: the children of index i
+	// are at 2*i+1 and 2*i+2.
+	items []pqItem[T]
+}
+
+// NewPriorityQueue creates a new empty PriorityQueue.
+//
+// Returns:
+//   - A new empty PriorityQueue instance
+//
+// Example:
+//
+//	pq := NewPriorityQueue[string]()
+//	pq.Push("low", 1)
+//	pq.Push("urgent", 0)
+//	v, _ := pq.Pop() // "urgent"
+func NewPriorityQueue[T any]() *PriorityQueue[T] {
+	return &PriorityQueue[T]{}
+}
+
+// Push adds an item with the given priority.
+//
+// This operation is O(log n).
+//
+// Parameters:
+//   - item: The value to enqueue
+//   - priority: The item's priority; lower values are popped first
+func (pq *PriorityQueue[T]) Push(item T, priority float64) {
+	pq.items = append(pq.items, pqItem[T]{value: item, priority: priority})
+	pq.up(len(pq.items) - 1)
+}
+
+// Pop removes and returns the item with the lowest priority.
+//
+// This operation is O(log n).
+//
+// Returns:
+//   - The lowest-priority item and true, or the zero value and false if the queue is empty
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if len(pq.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	last := len(pq.items) - 1
+	top := pq.items[0].value
+	pq.items[0] = pq.items[last]
+	pq.items[last] = pqItem[T]{} // release the reference for GC
+	pq.items = pq.items[:last]
+	pq.down(0)
+
+	return top, true
+}
+
+// Peek returns the item with the lowest priority without removing it.
+//
+// This operation is O(1) and does not modify the queue.
+//
+// Returns:
+//   - The lowest-priority item and true, or the zero value and false if the queue is empty
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// Len returns the current number of items in the queue.
+//
+// Returns:
+//   - The current number of items in the queue
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+// IsEmpty returns true if the queue contains no items.
+//
+// Returns:
+//   - true if the queue is empty, false otherwise
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.items) == 0
+}
+
+// up moves the item at index i toward the root until the heap property holds.
+func (pq *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if pq.items[parent].priority <= pq.items[i].priority {
+			return
+		}
+		pq.items[parent], pq.items[i] = pq.items[i], pq.items[parent]
+		i = parent
+	}
+}
+
+// down moves the item at index i toward the leaves until the heap property holds.
+func (pq *PriorityQueue[T]) down(i int) {
+	n := len(pq.items)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && pq.items[left].priority < pq.items[smallest].priority {
+			smallest = left
+		}
+		if right < n && pq.items[right].priority < pq.items[smallest].priority {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+}
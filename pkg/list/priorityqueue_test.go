@@ -0,0 +1,134 @@
+package list
+
+import (
+	"container/heap"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// refHeap is a container/heap reference implementation of a min-heap of priorities.
+type refHeap []float64
+
+func (h refHeap) Len() int           { return len(h) }
+func (h refHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h refHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *refHeap) Push(x any)        { *h = append(*h, x.(float64)) }
+func (h *refHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+func assertHeapProperty[T any](t *testing.T, pq *PriorityQueue[T]) {
+	t.Helper()
+	for i := 1; i < len(pq.items); i++ {
+		parent := (i - 1) / 2
+		if pq.items[parent].priority > pq.items[i].priority {
+			t.Fatalf("heap property violated at index %d: parent %v > child %v",
+				i, pq.items[parent].priority, pq.items[i].priority)
+		}
+	}
+}
+
+func TestNewPriorityQueue(t *testing.T) {
+	t.Run("should create empty priority queue", func(t *testing.T) {
+		pq := NewPriorityQueue[string]()
+
+		assert.NotNil(t, pq)
+		assert.Equal(t, 0, pq.Len())
+		assert.True(t, pq.IsEmpty())
+	})
+}
+
+func TestPriorityQueuePushPop(t *testing.T) {
+	t.Run("should pop items in ascending priority", func(t *testing.T) {
+		pq := NewPriorityQueue[string]()
+		pq.Push("c", 3)
+		pq.Push("a", 1)
+		pq.Push("d", 4)
+		pq.Push("b", 2)
+
+		assert.Equal(t, 4, pq.Len())
+		for _, expected := range []string{"a", "b", "c", "d"} {
+			v, ok := pq.Pop()
+			assert.True(t, ok)
+			assert.Equal(t, expected, v)
+		}
+		assert.True(t, pq.IsEmpty())
+	})
+
+	t.Run("should handle negative and equal priorities", func(t *testing.T) {
+		pq := NewPriorityQueue[int]()
+		pq.Push(1, 0)
+		pq.Push(2, -1.5)
+		pq.Push(3, 0)
+
+		v, _ := pq.Pop()
+		assert.Equal(t, 2, v)
+
+		first, _ := pq.Pop()
+		second, _ := pq.Pop()
+		assert.ElementsMatch(t, []int{1, 3}, []int{first, second})
+	})
+
+	t.Run("should return false when popping empty queue", func(t *testing.T) {
+		pq := NewPriorityQueue[*int]()
+
+		v, ok := pq.Pop()
+		assert.False(t, ok)
+		assert.Nil(t, v)
+	})
+}
+
+func TestPriorityQueuePeek(t *testing.T) {
+	t.Run("should peek without removing", func(t *testing.T) {
+		pq := NewPriorityQueue[string]()
+		pq.Push("later", 10)
+		pq.Push("sooner", 5)
+
+		v, ok := pq.Peek()
+		assert.True(t, ok)
+		assert.Equal(t, "sooner", v)
+		assert.Equal(t, 2, pq.Len())
+	})
+
+	t.Run("should return false when peeking empty queue", func(t *testing.T) {
+		pq := NewPriorityQueue[string]()
+
+		v, ok := pq.Peek()
+		assert.False(t, ok)
+		assert.Empty(t, v)
+	})
+}
+
+func TestPriorityQueueMatchesContainerHeap(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	pq := NewPriorityQueue[float64]()
+	ref := &refHeap{}
+
+	for i := 0; i < 5000; i++ {
+		if ref.Len() == 0 || rng.IntN(3) > 0 {
+			p := float64(rng.IntN(100))
+			pq.Push(p, p)
+			heap.Push(ref, p)
+		} else {
+			got, ok := pq.Pop()
+			require.True(t, ok)
+			require.Equal(t, heap.Pop(ref).(float64), got)
+		}
+
+		require.Equal(t, ref.Len(), pq.Len())
+		assertHeapProperty(t, pq)
+	}
+
+	for ref.Len() > 0 {
+		got, ok := pq.Pop()
+		require.True(t, ok)
+		require.Equal(t, heap.Pop(ref).(float64), got)
+	}
+	assert.True(t, pq.IsEmpty())
+}
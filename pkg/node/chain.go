@@ -0,0 +1,159 @@
+package node
+
+// HasCycle reports whether following Next() links from start ever revisits a node.
+//
+// It uses Floyd's tortoise-and-hare algorithm: one pointer advances one node
+// at a time and another advances two, so they can only meet if the chain
+// loops back on itself. Only Next() links are inspected; Prev() links are ignored.
+//
+// Time complexity: O(n), Space complexity: O(1)
+//
+// Parameters:
+//   - start: The node to start walking from, or nil
+//
+// Returns:
+//   - true if the chain reachable from start contains a cycle, false otherwise
+//
+// Example:
+//
+//	a, b, c := ID(1), ID(2), ID(3)
+//	a.WithNext(b)
+//	b.WithNext(c)
+//	c.WithNext(a)
+//	HasCycle(a) // true
+func HasCycle(start *Node) bool {
+	return meetingPoint(start) != nil
+}
+
+// CycleStart returns the first node of the cycle reachable from start.
+//
+// After the tortoise and hare meet inside the cycle, a second pointer
+// restarted from start and the tortoise advance in lockstep; they meet
+// exactly at the node where the cycle begins.
+//
+// Time complexity: O(n), Space complexity: O(1)
+//
+// Parameters:
+//   - start: The node to start walking from, or nil
+//
+// Returns:
+//   - The node where the cycle begins, or nil if the chain is acyclic
+//
+// Example:
+//
+//	a, b, c := ID(1), ID(2), ID(3)
+//	a.WithNext(b)
+//	b.WithNext(c)
+//	c.WithNext(b)
+//	CycleStart(a) // returns b
+func CycleStart(start *Node) *Node {
+	meet := meetingPoint(start)
+	if meet == nil {
+		return nil
+	}
+
+	for start != meet {
+		start = start.next
+		meet = meet.next
+	}
+
+	return start
+}
+
+// meetingPoint returns the node where the tortoise and hare meet, or nil if
+// the hare reaches the end of the chain.
+func meetingPoint(start *Node) *Node {
+	slow, fast := start, start
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+		if slow == fast {
+			return slow
+		}
+	}
+
+	return nil
+}
+
+// Reverse reverses the chain starting at head in place and returns the new head.
+//
+// Every node reachable from head through Next() links has its Next() and
+// Prev() references re-pointed, so the old tail becomes the new head and the
+// old head becomes the new tail. No nodes are allocated. The chain must be
+// acyclic; see HasCycle.
+//
+// Time complexity: O(n), Space complexity: O(1)
+//
+// Parameters:
+//   - head: The first node of the chain, or nil
+//
+// Returns:
+//   - The new head (the old tail), or nil if head is nil
+//
+// Example:
+//
+//	// 1 -> 2 -> 3
+//	head = Reverse(head)
+//	// 3 -> 2 -> 1
+func Reverse(head *Node) *Node {
+	var prev *Node
+	for curr := head; curr != nil; {
+		next := curr.next
+		curr.next = prev
+		curr.prev = next
+		prev = curr
+		curr = next
+	}
+
+	return prev
+}
+
+// Len returns the number of nodes reachable from head through Next() links,
+// including head itself.
+//
+// The chain must be acyclic, otherwise Len never returns; callers that build
+// chains dynamically should check HasCycle first.
+//
+// Time complexity: O(n), Space complexity: O(1)
+//
+// Parameters:
+//   - head: The first node of the chain, or nil
+//
+// Returns:
+//   - The length of the chain, or 0 if head is nil
+func Len(head *Node) int {
+	n := 0
+	for curr := head; curr != nil; curr = curr.next {
+		n++
+	}
+
+	return n
+}
+
+// ToSlice returns the nodes reachable from head through Next() links, in order.
+//
+// The chain must be acyclic, otherwise ToSlice never returns; callers that
+// build chains dynamically should check HasCycle first.
+//
+// Time complexity: O(n), Space complexity: O(n)
+//
+// Parameters:
+//   - head: The first node of the chain, or nil
+//
+// Returns:
+//   - A new slice holding the chain's nodes, or an empty slice if head is nil
+//
+// Example:
+//
+//	// 1 -> 2 -> 3
+//	for _, n := range ToSlice(head) {
+//		fmt.Println(n.ID()) // Prints: 1, 2, 3
+//	}
+func ToSlice(head *Node) []*Node {
+	nodes := make([]*Node, 0, Len(head))
+	for curr := head; curr != nil; curr = curr.next {
+		nodes = append(nodes, curr)
+	}
+
+	return nodes
+}
@@ -0,0 +1,123 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ChainTestSuite tests helpers operating on whole node chains
+type ChainTestSuite struct {
+	suite.Suite
+}
+
+// chain links n fresh nodes with IDs 1..n through both Next and Prev.
+func chain(n int) []*Node {
+	nodes := make([]*Node, n)
+	for i := range nodes {
+		nodes[i] = ID(uint64(i + 1))
+	}
+	for i := 0; i < n-1; i++ {
+		nodes[i].WithNext(nodes[i+1])
+		nodes[i+1].WithPrev(nodes[i])
+	}
+	return nodes
+}
+
+func (s *ChainTestSuite) TestHasCycle_Acyclic() {
+	s.Require().False(HasCycle(nil))
+	s.Require().False(HasCycle(ID(1)))
+
+	for _, n := range []int{2, 3, 10} {
+		nodes := chain(n)
+		s.Require().False(HasCycle(nodes[0]), "length %d", n)
+		s.Require().Nil(CycleStart(nodes[0]), "length %d", n)
+	}
+}
+
+func (s *ChainTestSuite) TestHasCycle_SelfLoop() {
+	n := ID(1)
+	n.WithNext(n)
+
+	s.Require().True(HasCycle(n))
+	s.Require().Same(n, CycleStart(n))
+}
+
+func (s *ChainTestSuite) TestCycleStart_EveryEntryPoint() {
+	const length = 7
+	for entry := 0; entry < length; entry++ {
+		nodes := chain(length)
+		nodes[length-1].WithNext(nodes[entry])
+
+		s.Require().True(HasCycle(nodes[0]), "entry %d", entry)
+		s.Require().Same(nodes[entry], CycleStart(nodes[0]), "entry %d", entry)
+	}
+}
+
+func (s *ChainTestSuite) TestCycleStart_StartInsideCycle() {
+	nodes := chain(4)
+	nodes[3].WithNext(nodes[1])
+
+	s.Require().True(HasCycle(nodes[2]))
+	s.Require().Same(nodes[2], CycleStart(nodes[2]))
+}
+
+func (s *ChainTestSuite) TestReverse() {
+	nodes := chain(3)
+
+	head := Reverse(nodes[0])
+	s.Require().Same(nodes[2], head)
+
+	var ids []uint64
+	for _, n := range NextNodes(head) {
+		ids = append(ids, n.ID())
+	}
+	s.Require().Equal([]uint64{3, 2, 1}, ids)
+
+	s.Require().Nil(head.Prev())
+	for n := head; n.Next() != nil; n = n.Next() {
+		s.Require().Same(n, n.Next().Prev(), "node %d", n.Next().ID())
+	}
+	s.Require().Nil(nodes[0].Next())
+
+	// Reversing twice restores the original order.
+	s.Require().Same(nodes[0], Reverse(head))
+	s.Require().Same(nodes[1], nodes[0].Next())
+	s.Require().Same(nodes[0], nodes[1].Prev())
+}
+
+func (s *ChainTestSuite) TestReverse_EdgeCases() {
+	s.Require().Nil(Reverse(nil))
+
+	single := ID(1)
+	s.Require().Same(single, Reverse(single))
+	s.Require().Nil(single.Next())
+	s.Require().Nil(single.Prev())
+}
+
+func (s *ChainTestSuite) TestReverse_DetachesFromPredecessor() {
+	nodes := chain(4)
+
+	head := Reverse(nodes[1])
+	s.Require().Same(nodes[3], head)
+	s.Require().Nil(nodes[1].Next(), "old head must become the tail")
+}
+
+func (s *ChainTestSuite) TestLenAndToSlice() {
+	s.Require().Equal(0, Len(nil))
+	s.Require().Empty(ToSlice(nil))
+
+	for _, n := range []int{1, 2, 5} {
+		nodes := chain(n)
+		s.Require().Equal(n, Len(nodes[0]))
+		s.Require().Equal(nodes, ToSlice(nodes[0]))
+	}
+
+	nodes := chain(5)
+	s.Require().Equal(3, Len(nodes[2]), "counts from head onward")
+	s.Require().Equal(nodes[2:], ToSlice(nodes[2]))
+}
+
+func TestChainTestSuite(t *testing.T) {
+	suite.Run(t, new(ChainTestSuite))
+}
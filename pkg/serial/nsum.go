@@ -53,6 +53,14 @@ func hashcode(base, val uint64) uint64 {
 //   - Generating consistent hash values for pairs of values
 //   - Building hash-based data structures with compound keys
 //
+// Collisions:
+// NSum is a hash, not a pairing function, and distinct pairs can map to the
+// same value. Because the inputs are ordered before hashing, NSum(a, b) always
+// equals NSum(b, a). Unrelated pairs collide as well, even among small IDs:
+// NSum(1, 70) == NSum(2, 3). Use NSum as a lookup hint or where an occasional
+// collision is harmless; use NPair when distinct ordered pairs must yield
+// distinct keys.
+//
 // Example:
 //
 //	// Create a hash from two node IDs
@@ -68,3 +76,31 @@ func NSum(from, to uint64) uint64 {
 	}
 	return hashcode(from, to)
 }
+
+// NPair maps an ordered pair of uint64 values to a single uint64 using
+// Szudzik's pairing function.
+//
+// Unlike NSum, NPair is order-sensitive and injective: for a, b < 2^32 every
+// distinct ordered pair yields a distinct result, so NPair(a, b) != NPair(b, a)
+// whenever a != b. The result is dense for small inputs, with NPair(a, b)
+// < (max(a, b)+1)^2. Inputs of 2^32 or more overflow and wrap modulo 2^64,
+// after which results are no longer guaranteed to be distinct.
+//
+// Parameters:
+//   - a: The first value of the pair
+//   - b: The second value of the pair
+//
+// Returns:
+//   - A value uniquely identifying the ordered pair (a, b) when a, b < 2^32
+//
+// Example:
+//
+//	// Key a directed edge so that (1, 2) and (2, 1) stay distinct
+//	forward := NPair(1, 2)  // 5
+//	backward := NPair(2, 1) // 7
+func NPair(a, b uint64) uint64 {
+	if a < b {
+		return b*b + a
+	}
+	return a*a + a + b
+}
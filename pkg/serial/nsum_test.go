@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -347,6 +348,57 @@ func TestNSum_CompositeKey(t *testing.T) {
 	assert.NotEqual(t, key1, differentSessionKey)
 }
 
+func TestNSum_Collisions(t *testing.T) {
+	// NSum is symmetric, so the two directions of an edge share a value.
+	assert.Equal(t, NSum(3, 7), NSum(7, 3))
+
+	// Unrelated pairs collide as well.
+	assert.Equal(t, NSum(1, 70), NSum(2, 3))
+	assert.Equal(t, NSum(1, 69), NSum(2, 4))
+
+	// NPair keeps all of them apart.
+	assert.NotEqual(t, NPair(3, 7), NPair(7, 3))
+	assert.NotEqual(t, NPair(1, 70), NPair(2, 3))
+	assert.NotEqual(t, NPair(1, 69), NPair(2, 4))
+}
+
+func TestNPair(t *testing.T) {
+	t.Run("should follow Szudzik ordering for small values", func(t *testing.T) {
+		// Szudzik's function enumerates the pairs shell by shell:
+		// (0,0) (0,1) (1,0) (1,1) (0,2) (1,2) (2,0) (2,1) (2,2) ...
+		pairs := [][2]uint64{{0, 0}, {0, 1}, {1, 0}, {1, 1}, {0, 2}, {1, 2}, {2, 0}, {2, 1}, {2, 2}}
+		for i, p := range pairs {
+			assert.Equal(t, uint64(i), NPair(p[0], p[1]), "NPair(%d, %d)", p[0], p[1])
+		}
+	})
+
+	t.Run("should be injective over ordered pairs", func(t *testing.T) {
+		const n = 300
+		seen := make(map[uint64][2]uint64, n*n)
+		for a := uint64(0); a < n; a++ {
+			for b := uint64(0); b < n; b++ {
+				key := NPair(a, b)
+				prev, dup := seen[key]
+				require.False(t, dup, "NPair(%d, %d) collides with NPair(%d, %d)", a, b, prev[0], prev[1])
+				seen[key] = [2]uint64{a, b}
+			}
+		}
+		// Dense: the n*n pairs below n occupy exactly [0, n*n).
+		assert.Len(t, seen, n*n)
+		for key := range seen {
+			assert.Less(t, key, uint64(n*n))
+		}
+	})
+
+	t.Run("should not collide at the 32-bit boundary", func(t *testing.T) {
+		const limit = uint64(1)<<32 - 1
+		assert.Equal(t, ^uint64(0), NPair(limit, limit))
+		assert.NotEqual(t, NPair(limit, limit-1), NPair(limit-1, limit))
+		assert.NotEqual(t, NPair(limit, 0), NPair(0, limit))
+		assert.Greater(t, NPair(limit, limit), NPair(limit, limit-1))
+	})
+}
+
 func ExampleNSum() {
 	// Hash two node IDs to create an edge identifier
 	nodeA := uint64(123)
@@ -123,6 +123,36 @@ func (s *Serial) Next(key string) uint64 {
 	return atomic.AddUint64(&s.shards[hash(key)].id, 1)
 }
 
+// NextN atomically reserves a contiguous block of n IDs for the given key.
+//
+// The counter is advanced by n in a single atomic operation, so the whole
+// block [start, end] belongs to the caller even under contention. After the
+// call, Current(key) returns end unless another goroutine has advanced it since.
+// When n is 0 nothing is reserved and start is end+1, describing an empty range.
+//
+// Parameters:
+//   - key: The string key that determines which shard to use
+//   - n: The number of IDs to reserve
+//
+// Returns:
+//   - start: The first reserved ID
+//   - end: The last reserved ID
+//
+// Thread Safety:
+// This method is fully thread-safe and can be called concurrently
+// from multiple goroutines without synchronization.
+//
+// Example:
+//
+//	serial := &Serial{}
+//	serial.Next("user")                    // Returns 1
+//	start, end := serial.NextN("user", 10) // Returns 2, 11
+//	serial.Next("user")                    // Returns 12
+func (s *Serial) NextN(key string, n uint64) (start, end uint64) {
+	end = atomic.AddUint64(&s.shards[hash(key)].id, n)
+	return end - n + 1, end
+}
+
 // Current returns the current ID value for the given key without incrementing.
 //
 // This method provides read-only access to the current counter value
@@ -149,6 +179,54 @@ func (s *Serial) Current(key string) uint64 {
 	return atomic.LoadUint64(&s.shards[hash(key)].id)
 }
 
+// Reset sets the counter for the given key back to 0.
+//
+// The next call to Next() with the same key returns 1 again, which makes
+// ID sequences reproducible across test runs.
+//
+// Keys are mapped to shards by hash, so distinct keys may share a counter;
+// resetting one key also resets any other key hashed to the same shard.
+//
+// Parameters:
+//   - key: The string key whose counter should be reset
+//
+// Thread Safety:
+// This method is fully thread-safe and can be called concurrently
+// with Next() and Current().
+//
+// Example:
+//
+//	serial := &Serial{}
+//	serial.Next("user")  // Returns 1
+//	serial.Reset("user")
+//	serial.Next("user")  // Returns 1
+func (s *Serial) Reset(key string) {
+	s.Set(key, 0)
+}
+
+// Set sets the counter for the given key to value.
+//
+// The next call to Next() with the same key returns value+1. As with Reset,
+// any other key hashed to the same shard is affected too.
+//
+// Parameters:
+//   - key: The string key whose counter should be set
+//   - value: The new current value for the key
+//
+// Thread Safety:
+// This method is fully thread-safe and can be called concurrently
+// with Next() and Current().
+//
+// Example:
+//
+//	serial := &Serial{}
+//	serial.Set("user", 100)
+//	serial.Current("user") // Returns 100
+//	serial.Next("user")    // Returns 101
+func (s *Serial) Set(key string, value uint64) {
+	atomic.StoreUint64(&s.shards[hash(key)].id, value)
+}
+
 var (
 	// ids is the singleton instance of the Serial generator.
 	// It's initialized once using sync.Once for thread-safe singleton pattern.
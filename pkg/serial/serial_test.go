@@ -132,6 +132,66 @@ func (s *BasicFunctionalityTestSuite) TestCurrent_DoesNotIncrement() {
 	assert.Equal(s.T(), uint64(1), current3)
 }
 
+func (s *BasicFunctionalityTestSuite) TestReset() {
+	serial := &Serial{}
+
+	serial.Next("test")
+	serial.Next("test")
+	serial.Reset("test")
+
+	assert.Equal(s.T(), uint64(0), serial.Current("test"), "Reset() should zero the counter")
+	assert.Equal(s.T(), uint64(1), serial.Next("test"), "Next() after Reset() should restart at 1")
+}
+
+func (s *BasicFunctionalityTestSuite) TestReset_OtherShardUntouched() {
+	serial := &Serial{}
+
+	keyA, keyB := "a", "b"
+	s.Require().NotEqual(hash(keyA), hash(keyB), "test keys must map to different shards")
+
+	serial.Next(keyA)
+	serial.Next(keyB)
+	serial.Reset(keyA)
+
+	assert.Equal(s.T(), uint64(0), serial.Current(keyA))
+	assert.Equal(s.T(), uint64(1), serial.Current(keyB))
+}
+
+func (s *BasicFunctionalityTestSuite) TestSet() {
+	serial := &Serial{}
+
+	serial.Set("test", 100)
+	assert.Equal(s.T(), uint64(100), serial.Current("test"))
+	assert.Equal(s.T(), uint64(101), serial.Next("test"))
+
+	serial.Set("test", 5)
+	assert.Equal(s.T(), uint64(6), serial.Next("test"), "Set() may move the counter backwards")
+}
+
+func (s *BasicFunctionalityTestSuite) TestNextN() {
+	serial := &Serial{}
+
+	serial.Next("test")
+	start, end := serial.NextN("test", 10)
+
+	assert.Equal(s.T(), uint64(2), start)
+	assert.Equal(s.T(), uint64(11), end)
+	assert.Equal(s.T(), end, serial.Current("test"), "Current() should equal the reserved end")
+	assert.Equal(s.T(), uint64(12), serial.Next("test"))
+}
+
+func (s *BasicFunctionalityTestSuite) TestNextN_SingleAndEmpty() {
+	serial := &Serial{}
+
+	start, end := serial.NextN("test", 1)
+	assert.Equal(s.T(), uint64(1), start)
+	assert.Equal(s.T(), uint64(1), end)
+
+	start, end = serial.NextN("test", 0)
+	assert.Equal(s.T(), end+1, start, "an empty reservation should describe an empty range")
+	assert.Equal(s.T(), uint64(1), serial.Current("test"))
+}
+
 // HashingTestSuite tests the hash function
 type HashingTestSuite struct {
 	suite.Suite
@@ -324,6 +384,74 @@ func (s *ConcurrencyTestSuite) TestMixedReadWrite() {
 	assert.Equal(s.T(), expected, final)
 }
 
+func (s *ConcurrencyTestSuite) TestSet_ConcurrentWithNext() {
+	serial := &Serial{}
+	key := "test"
+	iterations := 1000
+	goroutines := 10
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines + 1)
+
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				serial.Next(key)
+			}
+		}()
+	}
+
+	go func() {
+		defer wg.Done()
+		for j := 0; j < iterations; j++ {
+			serial.Set(key, 0)
+		}
+	}()
+
+	wg.Wait()
+
+	assert.LessOrEqual(s.T(), serial.Current(key), uint64(goroutines*iterations),
+		"counter can never exceed the number of Next() calls after a Set(0)")
+}
+
+func (s *ConcurrencyTestSuite) TestNextN_ConcurrentBlocksDisjoint() {
+	serial := &Serial{}
+	key := "test"
+	const (
+		goroutines = 10
+		blocks     = 100
+		blockSize  = 7
+	)
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = make(map[uint64]struct{}, goroutines*blocks*blockSize)
+	)
+	wg.Add(goroutines)
+
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < blocks; j++ {
+				start, end := serial.NextN(key, blockSize)
+				mu.Lock()
+				for id := start; id <= end; id++ {
+					seen[id] = struct{}{}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	total := uint64(goroutines * blocks * blockSize)
+	assert.Len(s.T(), seen, int(total), "reserved blocks must not overlap")
+	assert.Equal(s.T(), total, serial.Current(key))
+}
+
 // SingletonTestSuite tests the Seq singleton
 type SingletonTestSuite struct {
 	suite.Suite
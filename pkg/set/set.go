@@ -0,0 +1,143 @@
+package set
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+// Set is an unordered collection of distinct ordered values.
+//
+// Membership is backed by a map, so Add, Remove and Contains run in O(1)
+// expected time. Because the element type is ordered, Sorted can return the
+// members in ascending order, which gives deterministic output where a plain
+// map[T]struct{} would not.
+//
+// Key features:
+//   - O(1) Add, Remove and Contains
+//   - Union, Intersect and Difference return new sets and leave their inputs untouched
+//   - Sorted returns the members in ascending order
+//   - The zero value is an empty set ready for use
+//
+// Thread Safety:
+// Set is not thread-safe. Concurrent access requires external
+// synchronization mechanisms.
+type Set[T cmp.Ordered] struct {
+	// items holds the members of the set.
+	items map[T]struct{}
+}
+
+// New creates a new set containing the given items. Duplicates are stored once.
+//
+// Parameters:
+//   - items: The initial members of the set
+//
+// Returns:
+//   - A new Set instance ready for use
+//
+// Example:
+//
+//	s := set.New(3, 1, 2, 3)
+//	s.Len()    // 3
+//	s.Sorted() // [1 2 3]
+func New[T cmp.Ordered](items ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+	return s
+}
+
+// Add inserts item into the set.
+//
+// Parameters:
+//   - item: The value to add
+//
+// Returns:
+//   - true if item was added, false if it was already present
+func (s *Set[T]) Add(item T) bool {
+	if _, ok := s.items[item]; ok {
+		return false
+	}
+
+	if s.items == nil {
+		s.items = make(map[T]struct{})
+	}
+	s.items[item] = struct{}{}
+	return true
+}
+
+// Remove deletes item from the set.
+//
+// Parameters:
+//   - item: The value to remove
+//
+// Returns:
+//   - true if item was present and removed, false otherwise
+func (s *Set[T]) Remove(item T) bool {
+	if _, ok := s.items[item]; !ok {
+		return false
+	}
+
+	delete(s.items, item)
+	return true
+}
+
+// Contains returns true if item is a member of the set.
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len returns the number of members in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Union returns a new set holding every member of s and other.
+// Neither input is modified.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	union := &Set[T]{items: make(map[T]struct{}, s.Len()+other.Len())}
+	maps.Copy(union.items, s.items)
+	maps.Copy(union.items, other.items)
+	return union
+}
+
+// Intersect returns a new set holding the members present in both s and other.
+// Neither input is modified.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+
+	intersection := &Set[T]{items: make(map[T]struct{}, small.Len())}
+	for item := range small.items {
+		if large.Contains(item) {
+			intersection.items[item] = struct{}{}
+		}
+	}
+	return intersection
+}
+
+// Difference returns a new set holding the members of s that are not in other.
+// Neither input is modified.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	difference := &Set[T]{items: make(map[T]struct{}, s.Len())}
+	for item := range s.items {
+		if !other.Contains(item) {
+			difference.items[item] = struct{}{}
+		}
+	}
+	return difference
+}
+
+// Sorted returns the members of the set in ascending order.
+// An empty set yields an empty, non-nil slice.
+func (s *Set[T]) Sorted() []T {
+	sorted := slices.Sorted(maps.Keys(s.items))
+	if sorted == nil {
+		return []T{}
+	}
+	return sorted
+}
@@ -0,0 +1,94 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// SetTestSuite tests set operations
+type SetTestSuite struct {
+	suite.Suite
+}
+
+func (s *SetTestSuite) TestNew() {
+	set := New(3, 1, 2, 3)
+	s.Require().Equal(3, set.Len())
+	s.Require().Equal([]int{1, 2, 3}, set.Sorted())
+
+	empty := New[string]()
+	s.Require().Equal(0, empty.Len())
+	s.Require().NotNil(empty.Sorted())
+	s.Require().Empty(empty.Sorted())
+}
+
+func (s *SetTestSuite) TestZeroValue() {
+	var set Set[string]
+	s.Require().Equal(0, set.Len())
+	s.Require().False(set.Contains("a"))
+	s.Require().False(set.Remove("a"))
+	s.Require().Empty(set.Sorted())
+
+	s.Require().True(set.Add("a"))
+	s.Require().True(set.Contains("a"))
+}
+
+func (s *SetTestSuite) TestAddRemoveContains() {
+	set := New[int]()
+	s.Require().True(set.Add(5))
+	s.Require().False(set.Add(5), "adding an existing member reports false")
+	s.Require().True(set.Add(1))
+	s.Require().Equal(2, set.Len())
+	s.Require().True(set.Contains(5))
+	s.Require().False(set.Contains(3))
+
+	s.Require().True(set.Remove(5))
+	s.Require().False(set.Remove(5))
+	s.Require().False(set.Contains(5))
+	s.Require().Equal([]int{1}, set.Sorted())
+}
+
+func (s *SetTestSuite) TestSorted() {
+	set := New("pear", "apple", "fig", "banana")
+	s.Require().Equal([]string{"apple", "banana", "fig", "pear"}, set.Sorted())
+}
+
+func (s *SetTestSuite) TestSetOperations() {
+	a := New(1, 2, 3, 4)
+	b := New(3, 4, 5)
+
+	s.Require().Equal([]int{1, 2, 3, 4, 5}, a.Union(b).Sorted())
+	s.Require().Equal([]int{3, 4}, a.Intersect(b).Sorted())
+	s.Require().Equal([]int{3, 4}, b.Intersect(a).Sorted())
+	s.Require().Equal([]int{1, 2}, a.Difference(b).Sorted())
+	s.Require().Equal([]int{5}, b.Difference(a).Sorted())
+
+	empty := New[int]()
+	s.Require().Equal(a.Sorted(), a.Union(empty).Sorted())
+	s.Require().Empty(a.Intersect(empty).Sorted())
+	s.Require().Equal(a.Sorted(), a.Difference(empty).Sorted())
+	s.Require().Empty(empty.Difference(a).Sorted())
+
+	// Inputs are left untouched.
+	s.Require().Equal([]int{1, 2, 3, 4}, a.Sorted())
+	s.Require().Equal([]int{3, 4, 5}, b.Sorted())
+}
+
+func (s *SetTestSuite) TestResultsAreIndependent() {
+	a := New(1, 2)
+	b := New(2, 3)
+
+	union := a.Union(b)
+	union.Add(10)
+	union.Remove(1)
+	s.Require().Equal([]int{1, 2}, a.Sorted())
+	s.Require().Equal([]int{2, 3}, b.Sorted())
+
+	diff := a.Difference(b)
+	diff.Add(7)
+	s.Require().False(a.Contains(7))
+}
+
+func TestSetTestSuite(t *testing.T) {
+	suite.Run(t, new(SetTestSuite))
+}
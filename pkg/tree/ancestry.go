@@ -0,0 +1,94 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// LCA returns the lowest common ancestor of a and b: the deepest node that has
+// both a and b in its subtree. A node counts as its own ancestor, so the LCA of
+// a node and one of its descendants is the node itself.
+//
+// Both parent chains are first aligned to the same depth and then walked up in
+// lockstep, which keeps the lookup O(depth).
+//
+// Returns an error if:
+//   - a or b is nil (ErrNil)
+//   - a and b belong to disjoint trees (ErrNoMatch)
+func LCA[T comparable](a, b *Node[T]) (*Node[T], error) {
+	switch {
+	case a == nil:
+		return nil, fmt.Errorf("lca first node: %w", ErrNil)
+	case b == nil:
+		return nil, fmt.Errorf("lca second node: %w", ErrNil)
+	}
+
+	depthA, depthB := a.Depth(), b.Depth()
+	for ; depthA > depthB; depthA-- {
+		a = a.parent
+	}
+	for ; depthB > depthA; depthB-- {
+		b = b.parent
+	}
+
+	for a != b {
+		if a.parent == nil || b.parent == nil {
+			return nil, ErrNoMatch
+		}
+		a, b = a.parent, b.parent
+	}
+
+	return a, nil
+}
+
+// PathToRoot returns the chain of nodes from n up to and including its topmost
+// ancestor, ordered leaf-first. A node without a parent yields just itself.
+func (n *Node[T]) PathToRoot() []*Node[T] {
+	path := make([]*Node[T], 0, n.Depth()+1)
+	for current := n; current != nil; current = current.parent {
+		path = append(path, current)
+	}
+
+	return path
+}
+
+// PathFromRoot returns the chain of nodes from the topmost ancestor of n down to
+// and including n, ordered root-first.
+func (n *Node[T]) PathFromRoot() []*Node[T] {
+	path := n.PathToRoot()
+	slices.Reverse(path)
+
+	return path
+}
+
+// Root returns the topmost ancestor of n, found by following Parent until a node
+// without a parent is reached. A root or detached node returns itself.
+func (n *Node[T]) Root() *Node[T] {
+	top := n
+	for top.HasParent() {
+		top = top.Parent()
+	}
+
+	return top
+}
+
+// Siblings returns the other children of the parent of n, excluding n itself,
+// ordered by ID. A node without a parent has no siblings and yields an empty slice.
+func (n *Node[T]) Siblings() []*Node[T] {
+	if !n.HasParent() {
+		return []*Node[T]{}
+	}
+
+	siblings := make([]*Node[T], 0, n.Parent().Breadth()-1)
+	for _, child := range n.Parent().ChildrenIter() {
+		if child.ID() != n.id {
+			siblings = append(siblings, child)
+		}
+	}
+	slices.SortFunc(siblings, func(a, b *Node[T]) int {
+		return cmp.Compare(a.id, b.id)
+	})
+
+	return siblings
+}
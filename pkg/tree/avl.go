@@ -0,0 +1,380 @@
+package tree
+
+import (
+	"cmp"
+
+	"github.com/barnowlsnest/go-datalib/pkg/node"
+)
+
+// AVL is a self-balancing binary search tree.
+//
+// It exposes the same API as BST and stores its values in BinaryNode
+// structures, but after every insertion and deletion it retraces the
+// affected path and applies rotations so that the heights of any node's two
+// subtrees differ by at most one. This keeps the height below
+// 1.44·log2(n+2), even for sorted input that degenerates a plain BST.
+//
+// Key features:
+//   - O(log n) worst-case search, insert and delete
+//   - All operations are iterative (no recursion) for better stack safety
+//   - O(1) Height, since every node caches its subtree height
+//   - Deletion moves the in-order successor node rather than copying its
+//     value, so node IDs always stay attached to their values
+//
+// Rotations move whole subtrees up and down, so AVL does not maintain
+// BinaryNode levels; hierarchy markers (IsRoot, IsLeft, IsRight) are kept
+// up to date.
+//
+// Thread Safety:
+// AVL is not thread-safe. Concurrent access requires external synchronization.
+type AVL[T cmp.Ordered] struct {
+	root *BinaryNode[T]
+	size int
+}
+
+// NewAVL creates a new empty AVL tree.
+//
+// Returns:
+//   - A new empty AVL instance ready for use
+//
+// Example:
+//
+//	avl := NewAVL[int]()
+//	avl.Insert(node.ID(1), 50)
+func NewAVL[T cmp.Ordered]() *AVL[T] {
+	return &AVL[T]{}
+}
+
+// Insert adds a new value to the tree and rebalances it.
+// Duplicate values are not allowed.
+// Time complexity: O(log n)
+//
+// Parameters:
+//   - n: The node providing the identity of the new entry
+//   - value: The value to insert
+//
+// Returns:
+//   - true if the value was inserted, false if n is nil or the value already exists
+//
+// Example:
+//
+//	avl := NewAVL[int]()
+//	inserted := avl.Insert(node.ID(1), 50) // returns true
+//	inserted = avl.Insert(node.ID(2), 50)  // returns false (duplicate value)
+func (avl *AVL[T]) Insert(n *node.Node, value T) bool {
+	if n == nil {
+		return false
+	}
+
+	newNode := NewBinaryNode(n, WithValue[T](value))
+
+	if avl.root == nil {
+		newNode.AsRoot()
+		avl.root = newNode
+		avl.size++
+		return true
+	}
+
+	var path []*BinaryNode[T]
+	current := avl.root
+
+	for {
+		if value == current.val {
+			return false
+		}
+
+		path = append(path, current)
+
+		if value < current.val {
+			if !current.HasLeft() {
+				avl.setLeft(current, newNode)
+				break
+			}
+			current = current.Left()
+		} else {
+			if !current.HasRight() {
+				avl.setRight(current, newNode)
+				break
+			}
+			current = current.Right()
+		}
+	}
+
+	avl.size++
+	avl.retrace(path)
+	return true
+}
+
+// Search finds a value in the tree.
+// Time complexity: O(log n)
+//
+// Parameters:
+//   - value: The value to search for
+//
+// Returns:
+//   - The BinaryNode containing the value if found, nil otherwise
+func (avl *AVL[T]) Search(value T) *BinaryNode[T] {
+	current := avl.root
+	for current != nil {
+		switch {
+		case value == current.val:
+			return current
+		case value < current.val:
+			current = current.Left()
+		default:
+			current = current.Right()
+		}
+	}
+	return nil
+}
+
+// Delete removes a value from the tree and rebalances it.
+// A node with two children is replaced by its in-order successor node.
+// Time complexity: O(log n)
+//
+// Parameters:
+//   - value: The value to delete
+//
+// Returns:
+//   - true if the value was found and deleted, false otherwise
+func (avl *AVL[T]) Delete(value T) bool {
+	var path []*BinaryNode[T]
+	current := avl.root
+
+	for current != nil && current.val != value {
+		path = append(path, current)
+		if value < current.val {
+			current = current.Left()
+		} else {
+			current = current.Right()
+		}
+	}
+
+	if current == nil {
+		return false
+	}
+
+	if current.HasLeft() && current.HasRight() {
+		idx := len(path)
+		path = append(path, current)
+
+		successor := current.Right()
+		for successor.HasLeft() {
+			path = append(path, successor)
+			successor = successor.Left()
+		}
+
+		// Detach the successor, then let it take current's place.
+		if parent := path[len(path)-1]; parent == current {
+			avl.setRight(current, successor.Right())
+		} else {
+			avl.setLeft(parent, successor.Right())
+		}
+
+		avl.setLeft(successor, current.Left())
+		avl.setRight(successor, current.Right())
+		successor.height = current.height
+		avl.replace(path[:idx], current, successor)
+		path[idx] = successor
+	} else {
+		child := current.Left()
+		if child == nil {
+			child = current.Right()
+		}
+		avl.replace(path, current, child)
+	}
+
+	current.WithLeft(nil)
+	current.WithRight(nil)
+	avl.size--
+	avl.retrace(path)
+	return true
+}
+
+// Min returns the node with the minimum value in the tree.
+// Time complexity: O(log n)
+//
+// Returns:
+//   - The BinaryNode with minimum value, or nil if the tree is empty
+func (avl *AVL[T]) Min() *BinaryNode[T] {
+	if avl.root == nil {
+		return nil
+	}
+
+	current := avl.root
+	for current.HasLeft() {
+		current = current.Left()
+	}
+	return current
+}
+
+// Max returns the node with the maximum value in the tree.
+// Time complexity: O(log n)
+//
+// Returns:
+//   - The BinaryNode with maximum value, or nil if the tree is empty
+func (avl *AVL[T]) Max() *BinaryNode[T] {
+	if avl.root == nil {
+		return nil
+	}
+
+	current := avl.root
+	for current.HasRight() {
+		current = current.Right()
+	}
+	return current
+}
+
+// InOrder performs an iterative in-order traversal, visiting values in
+// ascending order.
+// Time complexity: O(n), Space complexity: O(log n)
+//
+// Parameters:
+//   - visit: Function to call for each node during traversal
+func (avl *AVL[T]) InOrder(visit func(*BinaryNode[T])) {
+	if visit == nil {
+		return
+	}
+
+	var stack []*BinaryNode[T]
+	current := avl.root
+
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = current.Left()
+		}
+
+		current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		visit(current)
+		current = current.Right()
+	}
+}
+
+// Height returns the height of the tree. An empty tree has height -1 and a
+// single node has height 0, matching BST.Height.
+// Time complexity: O(1)
+func (avl *AVL[T]) Height() int {
+	return avlHeight(avl.root)
+}
+
+// Size returns the number of nodes in the tree.
+// Time complexity: O(1)
+func (avl *AVL[T]) Size() int {
+	return avl.size
+}
+
+// IsEmpty returns true if the tree contains no nodes.
+// Time complexity: O(1)
+func (avl *AVL[T]) IsEmpty() bool {
+	return avl.size == 0
+}
+
+// Root returns the root node of the tree.
+// Time complexity: O(1)
+func (avl *AVL[T]) Root() *BinaryNode[T] {
+	return avl.root
+}
+
+// retrace walks path from the deepest node back to the root, refreshing
+// heights and rotating wherever a node has become unbalanced.
+func (avl *AVL[T]) retrace(path []*BinaryNode[T]) {
+	for i := len(path) - 1; i >= 0; i-- {
+		n := path[i]
+		if balanced := avl.rebalance(n); balanced != n {
+			avl.replace(path[:i], n, balanced)
+		}
+	}
+}
+
+// replace links sub into the position held by old, whose parent is the
+// last node of ancestors (or the root slot when ancestors is empty).
+func (avl *AVL[T]) replace(ancestors []*BinaryNode[T], old, sub *BinaryNode[T]) {
+	if len(ancestors) == 0 {
+		avl.root = sub
+		if sub != nil {
+			sub.AsRoot()
+		}
+		return
+	}
+
+	parent := ancestors[len(ancestors)-1]
+	if parent.Left() == old {
+		avl.setLeft(parent, sub)
+	} else {
+		avl.setRight(parent, sub)
+	}
+}
+
+// rebalance refreshes n's height and, if its subtrees differ in height by
+// more than one, rotates it. It returns the root of the resulting subtree.
+func (avl *AVL[T]) rebalance(n *BinaryNode[T]) *BinaryNode[T] {
+	avlUpdateHeight(n)
+
+	switch balance := avlBalance(n); {
+	case balance > 1:
+		if avlBalance(n.Left()) < 0 {
+			avl.setLeft(n, avl.rotateLeft(n.Left()))
+		}
+		return avl.rotateRight(n)
+	case balance < -1:
+		if avlBalance(n.Right()) > 0 {
+			avl.setRight(n, avl.rotateRight(n.Right()))
+		}
+		return avl.rotateLeft(n)
+	default:
+		return n
+	}
+}
+
+// rotateLeft lifts n's right child above n and returns it.
+func (avl *AVL[T]) rotateLeft(n *BinaryNode[T]) *BinaryNode[T] {
+	pivot := n.Right()
+	avl.setRight(n, pivot.Left())
+	avl.setLeft(pivot, n)
+	avlUpdateHeight(n)
+	avlUpdateHeight(pivot)
+	return pivot
+}
+
+// rotateRight lifts n's left child above n and returns it.
+func (avl *AVL[T]) rotateRight(n *BinaryNode[T]) *BinaryNode[T] {
+	pivot := n.Left()
+	avl.setLeft(n, pivot.Right())
+	avl.setRight(pivot, n)
+	avlUpdateHeight(n)
+	avlUpdateHeight(pivot)
+	return pivot
+}
+
+// setLeft links child as parent's left child and marks it accordingly.
+func (avl *AVL[T]) setLeft(parent, child *BinaryNode[T]) {
+	parent.WithLeft(child)
+	if child != nil {
+		child.AsLeft()
+	}
+}
+
+// setRight links child as parent's right child and marks it accordingly.
+func (avl *AVL[T]) setRight(parent, child *BinaryNode[T]) {
+	parent.WithRight(child)
+	if child != nil {
+		child.AsRight()
+	}
+}
+
+func avlHeight[T cmp.Ordered](n *BinaryNode[T]) int {
+	if n == nil {
+		return -1
+	}
+	return n.height
+}
+
+func avlUpdateHeight[T cmp.Ordered](n *BinaryNode[T]) {
+	n.height = 1 + max(avlHeight(n.Left()), avlHeight(n.Right()))
+}
+
+func avlBalance[T cmp.Ordered](n *BinaryNode[T]) int {
+	return avlHeight(n.Left()) - avlHeight(n.Right())
+}
@@ -0,0 +1,218 @@
+package tree
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/barnowlsnest/go-datalib/pkg/node"
+)
+
+// AVLTestSuite tests AVL tree operations
+type AVLTestSuite struct {
+	suite.Suite
+	avl *AVL[int]
+}
+
+func (s *AVLTestSuite) SetupTest() {
+	s.avl = NewAVL[int]()
+}
+
+// checkAVLInvariants verifies ordering, cached heights, balance factors,
+// hierarchy markers and size.
+func (s *AVLTestSuite) checkAVLInvariants() {
+	var check func(n *BinaryNode[int], lo, hi *int) (int, int)
+	check = func(n *BinaryNode[int], lo, hi *int) (height, count int) {
+		if n == nil {
+			return -1, 0
+		}
+		if lo != nil {
+			s.Require().Greater(n.Value(), *lo)
+		}
+		if hi != nil {
+			s.Require().Less(n.Value(), *hi)
+		}
+		if n.HasLeft() {
+			s.Require().True(n.Left().IsLeft())
+		}
+		if n.HasRight() {
+			s.Require().True(n.Right().IsRight())
+		}
+
+		v := n.Value()
+		lh, lc := check(n.Left(), lo, &v)
+		rh, rc := check(n.Right(), &v, hi)
+		s.Require().LessOrEqual(lh-rh, 1, "node %d unbalanced", v)
+		s.Require().GreaterOrEqual(lh-rh, -1, "node %d unbalanced", v)
+
+		height = 1 + max(lh, rh)
+		s.Require().Equal(height, n.height, "stale height at node %d", v)
+		return height, lc + rc + 1
+	}
+
+	if root := s.avl.Root(); root != nil {
+		s.Require().True(root.IsRoot())
+	}
+	height, count := check(s.avl.Root(), nil, nil)
+	s.Require().Equal(s.avl.Size(), count)
+	s.Require().Equal(height, s.avl.Height())
+}
+
+func (s *AVLTestSuite) collect() []int {
+	var values []int
+	s.avl.InOrder(func(n *BinaryNode[int]) {
+		values = append(values, n.Value())
+	})
+	return values
+}
+
+func (s *AVLTestSuite) TestEmpty() {
+	s.Require().True(s.avl.IsEmpty())
+	s.Require().Equal(-1, s.avl.Height())
+	s.Require().Nil(s.avl.Min())
+	s.Require().Nil(s.avl.Max())
+	s.Require().Nil(s.avl.Search(1))
+	s.Require().False(s.avl.Delete(1))
+	s.Require().Empty(s.collect())
+}
+
+func (s *AVLTestSuite) TestInsert() {
+	s.Require().True(s.avl.Insert(node.ID(1), 50))
+	s.Require().True(s.avl.Insert(node.ID(2), 30))
+	s.Require().False(s.avl.Insert(node.ID(3), 50), "duplicate value")
+	s.Require().False(s.avl.Insert(nil, 10), "nil node")
+
+	s.Require().Equal(2, s.avl.Size())
+	s.Require().Equal([]int{30, 50}, s.collect())
+	s.checkAVLInvariants()
+}
+
+func (s *AVLTestSuite) TestRotations() {
+	testCases := []struct {
+		name   string
+		values []int
+	}{
+		{name: "left-left", values: []int{30, 20, 10}},
+		{name: "right-right", values: []int{10, 20, 30}},
+		{name: "left-right", values: []int{30, 10, 20}},
+		{name: "right-left", values: []int{10, 30, 20}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.avl = NewAVL[int]()
+			for i, v := range tc.values {
+				s.avl.Insert(node.ID(uint64(i+1)), v)
+			}
+
+			s.Require().Equal(20, s.avl.Root().Value())
+			s.Require().Equal(1, s.avl.Height())
+			s.checkAVLInvariants()
+		})
+	}
+}
+
+func (s *AVLTestSuite) TestAscendingInsertsStayLogarithmic() {
+	const n = 10000
+	for i := 1; i <= n; i++ {
+		s.Require().True(s.avl.Insert(node.ID(uint64(i)), i))
+	}
+
+	s.Require().Equal(n, s.avl.Size())
+	s.Require().LessOrEqual(float64(s.avl.Height()), 1.44*math.Log2(n+2))
+	s.Require().Equal(1, s.avl.Min().Value())
+	s.Require().Equal(n, s.avl.Max().Value())
+	s.checkAVLInvariants()
+}
+
+func (s *AVLTestSuite) TestSearch() {
+	for i, v := range []int{50, 30, 70, 20, 40, 60, 80} {
+		s.avl.Insert(node.ID(uint64(i+1)), v)
+	}
+
+	found := s.avl.Search(40)
+	s.Require().NotNil(found)
+	s.Require().Equal(40, found.Value())
+	s.Require().Equal(uint64(5), found.ID())
+	s.Require().Nil(s.avl.Search(45))
+}
+
+func (s *AVLTestSuite) TestDelete() {
+	values := []int{50, 30, 70, 20, 40, 60, 80, 35, 45, 65}
+	for i, v := range values {
+		s.avl.Insert(node.ID(uint64(i+1)), v)
+	}
+
+	s.Run("two children keeps IDs with values", func() {
+		s.Require().True(s.avl.Delete(30))
+		s.Require().Nil(s.avl.Search(30))
+
+		successor := s.avl.Search(35)
+		s.Require().NotNil(successor)
+		s.Require().Equal(uint64(8), successor.ID())
+		s.checkAVLInvariants()
+	})
+
+	s.Run("root", func() {
+		root := s.avl.Root().Value()
+		s.Require().True(s.avl.Delete(root))
+		s.Require().Nil(s.avl.Search(root))
+		s.checkAVLInvariants()
+	})
+
+	s.Run("leaf and missing", func() {
+		s.Require().True(s.avl.Delete(80))
+		s.Require().False(s.avl.Delete(80))
+		s.checkAVLInvariants()
+	})
+
+	s.Require().Equal([]int{20, 35, 40, 45, 60, 65, 70}, s.collect())
+	s.Require().Equal(7, s.avl.Size())
+}
+
+func (s *AVLTestSuite) TestRandomOperations() {
+	rng := rand.New(rand.NewPCG(5, 6))
+	present := make(map[int]bool)
+	var id uint64
+
+	for i := 0; i < 3000; i++ {
+		v := rng.IntN(500)
+		if rng.IntN(3) == 0 {
+			s.Require().Equal(present[v], s.avl.Delete(v))
+			delete(present, v)
+		} else {
+			id++
+			s.Require().Equal(!present[v], s.avl.Insert(node.ID(id), v))
+			present[v] = true
+		}
+
+		if i%100 == 0 {
+			s.checkAVLInvariants()
+		}
+	}
+
+	s.checkAVLInvariants()
+	s.Require().Equal(len(present), s.avl.Size())
+	for v := range present {
+		s.Require().NotNil(s.avl.Search(v))
+	}
+}
+
+func (s *AVLTestSuite) TestDeleteAll() {
+	for i := 1; i <= 100; i++ {
+		s.avl.Insert(node.ID(uint64(i)), i)
+	}
+	for i := 1; i <= 100; i++ {
+		s.Require().True(s.avl.Delete(i))
+		s.checkAVLInvariants()
+	}
+
+	s.Require().True(s.avl.IsEmpty())
+	s.Require().Nil(s.avl.Root())
+}
+
+func TestAVLTestSuite(t *testing.T) {
+	suite.Run(t, new(AVLTestSuite))
+}
@@ -15,10 +15,18 @@ const (
 type (
 	BinaryNodeOption[T cmp.Ordered] func(bn *BinaryNode[T])
 
+	// BinaryNode is a node of the binary search trees in this package (BST and
+	// AVL). It embeds a node.Node for its ID and links to its left and right
+	// children.
+	//
+	// The height field caches the subtree height for AVL rebalancing. Only AVL
+	// maintains it; for nodes of any other tree it is always zero and carries
+	// no meaning.
 	BinaryNode[T cmp.Ordered] struct {
 		val       T
 		hierarchy int
 		level     int
+		height    int // AVL only, see the type comment
 		*node.Node
 		left  *BinaryNode[T]
 		right *BinaryNode[T]
@@ -2,6 +2,7 @@ package tree
 
 import (
 	"cmp"
+	"iter"
 
 	"github.com/barnowlsnest/go-datalib/pkg/list"
 	"github.com/barnowlsnest/go-datalib/pkg/node"
@@ -151,7 +152,7 @@ func (bst *BST[T]) Search(value T) *BinaryNode[T] {
 // This is an iterative implementation that handles three cases:
 //  1. CreateNode with no children (leaf): remove
 //  2. CreateNode with one child: replace a node with its child
-//  3. CreateNode with two children: replace it with inorder successor (leftmost node in right subtree)
+//  3. CreateNode with two children: splice in the inorder successor node (leftmost node in right subtree)
 //
 // Parameters:
 //   - value: The value to delete from the tree
@@ -189,7 +190,7 @@ func (bst *BST[T]) Delete(value T) bool {
 		bst.deleteNodeWithOneChild(p, current, isLeftChild)
 	default:
 		// Case 3: CreateNode with two children
-		bst.deleteNodeWithTwoChildren(current)
+		bst.deleteNodeWithTwoChildren(p, current, isLeftChild)
 	}
 
 	bst.size--
@@ -260,19 +261,45 @@ func (bst *BST[T]) deleteNodeWithOneChild(parent, current *BinaryNode[T], isLeft
 	}
 }
 
-// deleteNodeWithTwoChildren removes a node with two children using inorder successor.
-func (bst *BST[T]) deleteNodeWithTwoChildren(current *BinaryNode[T]) {
+// deleteNodeWithTwoChildren removes a node with two children by splicing its
+// inorder successor node into its place. The successor node is moved rather
+// than having its value copied, so every node keeps its original ID and value.
+func (bst *BST[T]) deleteNodeWithTwoChildren(parent, current *BinaryNode[T], isLeftChild bool) {
 	// Find inorder successor (leftmost node in right subtree)
-	successor := bst.findMin(current.Right())
+	successorParent := current
+	successor := current.Right()
+	for successor.HasLeft() {
+		successorParent = successor
+		successor = successor.Left()
+	}
+
+	// Detach the successor (it has at most one child - right child)
+	if successorParent != current {
+		successorParent.WithLeft(successor.Right())
+		if successor.HasRight() {
+			successor.Right().AsLeft()
+		}
+		successor.WithRight(current.Right())
+	}
 
-	// Delete successor (it has at most one child - right child)
-	bst.Delete(successor.val)
+	// Let the successor take current's place
+	successor.WithLeft(current.Left())
+	successor.WithLevel(current.Level())
 
-	// Replace the current node's value with the successor's value
-	current.WithValue(successor.val)
+	switch {
+	case current == bst.root:
+		bst.root = successor
+		successor.AsRoot()
+	case isLeftChild:
+		parent.WithLeft(successor)
+		successor.AsLeft()
+	default:
+		parent.WithRight(successor)
+		successor.AsRight()
+	}
 
-	// Compensate for the recursive delete that decremented size
-	bst.size++
+	current.WithLeft(nil)
+	current.WithRight(nil)
 }
 
 // findMin finds the node with a minimum value in a subtree (iterative).
@@ -330,6 +357,68 @@ func (bst *BST[T]) Max() *BinaryNode[T] {
 	return current
 }
 
+// Successor returns the node holding the smallest value strictly greater than value.
+// The value itself does not need to be present in the tree.
+// Time complexity: O(h) where h is the height of the tree.
+//
+// Parameters:
+//   - value: The value whose in-order successor is requested
+//
+// Returns:
+//   - The successor BinaryNode, or nil if no greater value exists
+//
+// Example:
+//
+//	bst := NewBST[int]()
+//	bst.Insert(node.ID(1), 50)
+//	bst.Insert(node.ID(2), 30)
+//	bst.Insert(node.ID(3), 70)
+//	next := bst.Successor(50) // returns node with value 70
+func (bst *BST[T]) Successor(value T) *BinaryNode[T] {
+	var successor *BinaryNode[T]
+	current := bst.root
+	for current != nil {
+		if value < current.Value() {
+			successor = current
+			current = current.Left()
+		} else {
+			current = current.Right()
+		}
+	}
+	return successor
+}
+
+// Predecessor returns the node holding the largest value strictly less than value.
+// The value itself does not need to be present in the tree.
+// Time complexity: O(h) where h is the height of the tree.
+//
+// Parameters:
+//   - value: The value whose in-order predecessor is requested
+//
+// Returns:
+//   - The predecessor BinaryNode, or nil if no smaller value exists
+//
+// Example:
+//
+//	bst := NewBST[int]()
+//	bst.Insert(node.ID(1), 50)
+//	bst.Insert(node.ID(2), 30)
+//	bst.Insert(node.ID(3), 70)
+//	prev := bst.Predecessor(50) // returns node with value 30
+func (bst *BST[T]) Predecessor(value T) *BinaryNode[T] {
+	var predecessor *BinaryNode[T]
+	current := bst.root
+	for current != nil {
+		if value > current.Value() {
+			predecessor = current
+			current = current.Right()
+		} else {
+			current = current.Left()
+		}
+	}
+	return predecessor
+}
+
 // InOrder performs an iterative in-order traversal (Left-CreateRootNode-Right) using a stack.
 // For a BST, this produces values in sorted ascending order.
 // Time complexity: O(n), Space complexity: O(h) where h is tree height.
@@ -383,6 +472,204 @@ func (bst *BST[T]) InOrder(visit func(*BinaryNode[T])) {
 	}
 }
 
+// Range visits, in ascending order, every node whose value lies in [low, high].
+// Subtrees that cannot hold in-range values are never descended, so the cost
+// is proportional to the height plus the number of nodes visited rather than
+// the size of the tree. Traversal stops as soon as visit returns false.
+// Time complexity: O(h + k) where k is the number of nodes in range.
+//
+// Parameters:
+//   - low: Inclusive lower bound
+//   - high: Inclusive upper bound
+//   - visit: Function to call for each in-range node; return false to stop
+//
+// Example:
+//
+//	bst := NewBST[int]()
+//	bst.Insert(node.ID(1), 50)
+//	bst.Insert(node.ID(2), 30)
+//	bst.Insert(node.ID(3), 70)
+//	bst.Range(40, 80, func(node *BinaryNode[int]) bool {
+//		fmt.Println(node.Value()) // Prints: 50, 70
+//		return true
+//	})
+func (bst *BST[T]) Range(low, high T, visit func(*BinaryNode[T]) bool) {
+	if bst.root == nil || visit == nil || low > high {
+		return
+	}
+
+	s := list.NewStack()
+	nodeMap := make(map[uint64]*BinaryNode[T])
+
+	bst.pushRangeLeft(s, bst.root, low, nodeMap)
+
+	for !s.IsEmpty() {
+		n := s.Pop()
+		if n == nil {
+			break
+		}
+
+		current := nodeMap[n.ID()]
+		if current.val > high || !visit(current) {
+			return
+		}
+
+		bst.pushRangeLeft(s, current.Right(), low, nodeMap)
+	}
+}
+
+// pushRangeLeft pushes the left spine of bn onto the stack, skipping every
+// node (and its left subtree) whose value is below low.
+func (bst *BST[T]) pushRangeLeft(s *list.Stack, bn *BinaryNode[T], low T, nodeMap map[uint64]*BinaryNode[T]) {
+	for bn != nil {
+		if bn.val < low {
+			bn = bn.Right()
+			continue
+		}
+
+		bst.addToStack(s, bn, nodeMap)
+		bn = bn.Left()
+	}
+}
+
+// KthSmallest returns the k-th smallest node in the tree, 1-indexed.
+// It walks the tree in order with a stack and stops as soon as the k-th
+// node is reached.
+// Time complexity: O(h + k), Space complexity: O(h)
+//
+// Parameters:
+//   - k: The 1-based rank of the node to return
+//
+// Returns:
+//   - The k-th smallest BinaryNode, or nil
+//   - false if k is less than 1 or greater than Size()
+//
+// Example:
+//
+//	bst := NewBST[int]()
+//	bst.Insert(node.ID(1), 50)
+//	bst.Insert(node.ID(2), 30)
+//	bst.Insert(node.ID(3), 70)
+//	n, ok := bst.KthSmallest(2) // returns node with value 50, true
+func (bst *BST[T]) KthSmallest(k int) (*BinaryNode[T], bool) {
+	if k < 1 || k > bst.size {
+		return nil, false
+	}
+
+	s := list.NewStack()
+	nodeMap := make(map[uint64]*BinaryNode[T])
+
+	for current := bst.root; current != nil; current = current.Left() {
+		bst.addToStack(s, current, nodeMap)
+	}
+
+	for !s.IsEmpty() {
+		n := s.Pop()
+		if n == nil {
+			break
+		}
+
+		current := nodeMap[n.ID()]
+		k--
+		if k == 0 {
+			return current, true
+		}
+
+		for next := current.Right(); next != nil; next = next.Left() {
+			bst.addToStack(s, next, nodeMap)
+		}
+	}
+
+	return nil, false
+}
+
+// KthLargest returns the k-th largest node in the tree, 1-indexed.
+// Time complexity: O(n) in the worst case, Space complexity: O(h)
+//
+// Parameters:
+//   - k: The 1-based rank, counted from the maximum, of the node to return
+//
+// Returns:
+//   - The k-th largest BinaryNode, or nil
+//   - false if k is less than 1 or greater than Size()
+//
+// Example:
+//
+//	bst := NewBST[int]()
+//	bst.Insert(node.ID(1), 50)
+//	bst.Insert(node.ID(2), 30)
+//	bst.Insert(node.ID(3), 70)
+//	n, ok := bst.KthLargest(1) // returns node with value 70, true
+func (bst *BST[T]) KthLargest(k int) (*BinaryNode[T], bool) {
+	if k < 1 || k > bst.size {
+		return nil, false
+	}
+	return bst.KthSmallest(bst.size - k + 1)
+}
+
+// Iter returns an iterator over the nodes in ascending value order.
+// The traversal is iterative and lazy: nodes are produced one at a time
+// and breaking out of the loop stops the walk without further work.
+// Time complexity: O(n) for a full pass, Space complexity: O(h)
+//
+// Example:
+//
+//	for n := range bst.Iter() {
+//		if n.Value() > 60 {
+//			break
+//		}
+//		fmt.Println(n.Value())
+//	}
+func (bst *BST[T]) Iter() iter.Seq[*BinaryNode[T]] {
+	return func(yield func(*BinaryNode[T]) bool) {
+		bst.iterate(yield, (*BinaryNode[T]).Left, (*BinaryNode[T]).Right)
+	}
+}
+
+// IterReverse returns an iterator over the nodes in descending value order.
+// Time complexity: O(n) for a full pass, Space complexity: O(h)
+//
+// Example:
+//
+//	for n := range bst.IterReverse() {
+//		fmt.Println(n.Value()) // Prints values from largest to smallest
+//	}
+func (bst *BST[T]) IterReverse() iter.Seq[*BinaryNode[T]] {
+	return func(yield func(*BinaryNode[T]) bool) {
+		bst.iterate(yield, (*BinaryNode[T]).Right, (*BinaryNode[T]).Left)
+	}
+}
+
+// iterate drives an in-order walk in which first selects the subtree visited
+// before a node and second the subtree visited after it.
+func (bst *BST[T]) iterate(
+	yield func(*BinaryNode[T]) bool,
+	first, second func(*BinaryNode[T]) *BinaryNode[T],
+) {
+	s := list.NewStack()
+	nodeMap := make(map[uint64]*BinaryNode[T])
+
+	for current := bst.root; current != nil; current = first(current) {
+		bst.addToStack(s, current, nodeMap)
+	}
+
+	for !s.IsEmpty() {
+		n := s.Pop()
+		if n == nil {
+			break
+		}
+
+		current := nodeMap[n.ID()]
+		if !yield(current) {
+			return
+		}
+
+		for next := second(current); next != nil; next = first(next) {
+			bst.addToStack(s, next, nodeMap)
+		}
+	}
+}
+
 // PreOrder performs an iterative pre-order traversal (Root-Left-Right) using a stack.
 // Time complexity: O(n), Space complexity: O(h) where h is tree height.
 //
@@ -571,6 +858,156 @@ func (bst *BST[T]) Height() int {
 	return height
 }
 
+// rebalanceSpan describes a slice of in-order nodes awaiting placement under parent.
+type rebalanceSpan[T cmp.Ordered] struct {
+	lo, hi int
+	level  int
+	parent *BinaryNode[T]
+	isLeft bool
+}
+
+// Rebalance rebuilds the tree into a height-balanced shape.
+// Nodes are collected in order and re-linked around successive midpoints, so
+// every value and node ID is preserved and only the links, levels and
+// hierarchy markers change. The size is left untouched.
+// Time complexity: O(n), Space complexity: O(n)
+//
+// Example:
+//
+//	bst := New[int]()
+//	for i := 1; i <= 7; i++ {
+//		bst.Insert(node.ID(uint64(i)), i)
+//	}
+//	bst.Height()    // returns 6 (right-skewed)
+//	bst.Rebalance()
+//	bst.Height()    // returns 2
+func (bst *BST[T]) Rebalance() {
+	if bst.root == nil {
+		return
+	}
+
+	nodes := make([]*BinaryNode[T], 0, bst.size)
+	bst.InOrder(func(bn *BinaryNode[T]) {
+		nodes = append(nodes, bn)
+	})
+
+	stack := []rebalanceSpan[T]{{lo: 0, hi: len(nodes) - 1}}
+	for len(stack) > 0 {
+		span := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if span.lo > span.hi {
+			continue
+		}
+
+		mid := span.lo + (span.hi-span.lo)/2
+		current := nodes[mid]
+		current.WithLeft(nil)
+		current.WithRight(nil)
+		current.WithLevel(span.level)
+
+		switch {
+		case span.parent == nil:
+			current.AsRoot()
+			bst.root = current
+		case span.isLeft:
+			current.AsLeft()
+			span.parent.WithLeft(current)
+		default:
+			current.AsRight()
+			span.parent.WithRight(current)
+		}
+
+		stack = append(stack,
+			rebalanceSpan[T]{lo: mid + 1, hi: span.hi, level: span.level + 1, parent: current},
+			rebalanceSpan[T]{lo: span.lo, hi: mid - 1, level: span.level + 1, parent: current, isLeft: true},
+		)
+	}
+}
+
+// IsValid reports whether the tree satisfies the BST invariant.
+// It walks the tree in order and confirms that values are strictly
+// increasing, which holds exactly when every left subtree is smaller and
+// every right subtree larger than its parent. The tracked size must also
+// match the number of reachable nodes.
+// Time complexity: O(n), Space complexity: O(h)
+//
+// Returns:
+//   - true if the ordering and size invariants hold, false otherwise
+//
+// Example:
+//
+//	bst := NewBST[int]()
+//	bst.Insert(node.ID(1), 50)
+//	bst.Insert(node.ID(2), 30)
+//	valid := bst.IsValid() // returns true
+func (bst *BST[T]) IsValid() bool {
+	var prev *BinaryNode[T]
+	count := 0
+
+	for current := range bst.Iter() {
+		if prev != nil && prev.val >= current.val {
+			return false
+		}
+		prev = current
+		count++
+	}
+
+	return count == bst.size
+}
+
+// Clone returns a deep copy of the tree.
+// Every BinaryNode and its underlying node is duplicated, so structural
+// changes on either tree are isolated from the other. Node IDs, values,
+// levels, hierarchy markers and the size are preserved.
+// Time complexity: O(n), Space complexity: O(n)
+//
+// Returns:
+//   - A new BST with the same shape and contents
+//
+// Example:
+//
+//	bst := NewBST[int]()
+//	bst.Insert(node.ID(1), 50)
+//	clone := bst.Clone()
+//	clone.Delete(50) // bst.Search(50) still finds the node
+func (bst *BST[T]) Clone() *BST[T] {
+	clone := &BST[T]{size: bst.size}
+	if bst.root == nil {
+		return clone
+	}
+
+	clone.root = bst.cloneNode(bst.root)
+
+	type pair struct{ src, dst *BinaryNode[T] }
+	stack := []pair{{src: bst.root, dst: clone.root}}
+
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if p.src.HasLeft() {
+			left := bst.cloneNode(p.src.Left())
+			p.dst.WithLeft(left)
+			stack = append(stack, pair{src: p.src.Left(), dst: left})
+		}
+		if p.src.HasRight() {
+			right := bst.cloneNode(p.src.Right())
+			p.dst.WithRight(right)
+			stack = append(stack, pair{src: p.src.Right(), dst: right})
+		}
+	}
+
+	return clone
+}
+
+// cloneNode copies bn without its children onto a fresh node with the same ID.
+func (bst *BST[T]) cloneNode(bn *BinaryNode[T]) *BinaryNode[T] {
+	cp := NewBinaryNode(node.ID(bn.ID()), WithValue[T](bn.val), WithLevel[T](bn.level))
+	cp.hierarchy = bn.hierarchy
+	return cp
+}
+
 // Size returns the number of nodes in the tree.
 // Time complexity: O(1)
 //
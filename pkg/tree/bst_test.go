@@ -1,6 +1,7 @@
 package tree
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -261,6 +262,34 @@ func (s *BSTTestSuite) TestDeleteAllNodes() {
 	assert.Nil(s.T(), s.bst.Root())
 }
 
+func (s *BSTTestSuite) TestDeleteTwoChildrenPreservesIDs() {
+	values := []int{50, 30, 70, 20, 40, 60, 80, 65}
+	s.buildTree(values)
+
+	want := make(map[uint64]int, len(values))
+	for i, v := range values {
+		want[uint64(i+1)] = v
+	}
+	successor := s.bst.Search(60)
+
+	// 50's successor 60 sits deep in the right subtree and has a right child;
+	// 30's successor 40 is its direct right child.
+	s.Require().True(s.bst.Delete(50))
+	s.Require().True(s.bst.Delete(30))
+	delete(want, 1)
+	delete(want, 2)
+
+	s.Require().Same(successor, s.bst.Root(), "the successor node itself replaces the root")
+	s.Require().True(s.bst.IsValid())
+	s.Require().Equal([]int{20, 40, 60, 65, 70, 80}, collectValuesInt(s.bst.InOrder))
+
+	got := make(map[uint64]int, s.bst.Size())
+	s.bst.InOrder(func(bn *BinaryNode[int]) {
+		got[bn.ID()] = bn.Value()
+	})
+	s.Require().Equal(want, got)
+}
+
 func (s *BSTTestSuite) TestTraversals() {
 	treeValues := []int{50, 30, 70, 20, 40, 80}
 	s.buildTree(treeValues)
@@ -583,6 +612,380 @@ func (s *BSTTestSuite) TestAllTraversalsVisitAllNodes() {
 	}
 }
 
+func (s *BSTTestSuite) TestSuccessorPredecessor() {
+	testCases := []struct {
+		name        string
+		value       int
+		successor   *int
+		predecessor *int
+	}{
+		{name: "root", value: 50, successor: intPtr(60), predecessor: intPtr(40)},
+		{name: "leaf", value: 40, successor: intPtr(50), predecessor: intPtr(30)},
+		{name: "minimum", value: 20, successor: intPtr(30), predecessor: nil},
+		{name: "maximum", value: 80, successor: nil, predecessor: intPtr(70)},
+		{name: "absent value between nodes", value: 55, successor: intPtr(60), predecessor: intPtr(50)},
+		{name: "absent value below minimum", value: 5, successor: intPtr(20), predecessor: nil},
+		{name: "absent value above maximum", value: 95, successor: nil, predecessor: intPtr(80)},
+	}
+
+	s.buildTree([]int{50, 30, 70, 20, 40, 60, 80})
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			succ := s.bst.Successor(tc.value)
+			if tc.successor == nil {
+				assert.Nil(s.T(), succ)
+			} else {
+				assert.NotNil(s.T(), succ)
+				assert.Equal(s.T(), *tc.successor, succ.Value())
+			}
+
+			pred := s.bst.Predecessor(tc.value)
+			if tc.predecessor == nil {
+				assert.Nil(s.T(), pred)
+			} else {
+				assert.NotNil(s.T(), pred)
+				assert.Equal(s.T(), *tc.predecessor, pred.Value())
+			}
+		})
+	}
+}
+
+func (s *BSTTestSuite) TestSuccessorPredecessorEmptyTree() {
+	assert.Nil(s.T(), s.bst.Successor(10))
+	assert.Nil(s.T(), s.bst.Predecessor(10))
+}
+
+func (s *BSTTestSuite) TestRebalance() {
+	s.Run("right-skewed tree", func() {
+		const n = 1000
+		bst := NewBST[int]()
+		for i := 1; i <= n; i++ {
+			bst.Insert(node.ID(uint64(i)), i)
+		}
+		s.Require().Equal(n-1, bst.Height())
+
+		bst.Rebalance()
+
+		s.Require().Equal(n, bst.Size())
+		s.Require().Equal(int(math.Ceil(math.Log2(n+1)))-1, bst.Height())
+		s.Require().True(bst.Root().IsRoot())
+
+		expected := 1
+		bst.InOrder(func(bn *BinaryNode[int]) {
+			s.Require().Equal(expected, bn.Value())
+			s.Require().Equal(uint64(expected), bn.ID())
+			expected++
+		})
+		s.Require().Equal(n+1, expected)
+
+		for i := 1; i <= n; i++ {
+			s.Require().NotNil(bst.Search(i))
+		}
+	})
+
+	s.Run("levels and hierarchy are refreshed", func() {
+		s.buildTree([]int{10, 20, 30, 40, 50, 60, 70})
+		s.bst.Rebalance()
+
+		root := s.bst.Root()
+		s.Require().Equal(40, root.Value())
+		s.Require().Equal(0, root.Level())
+		s.Require().Equal(20, root.Left().Value())
+		s.Require().True(root.Left().IsLeft())
+		s.Require().Equal(1, root.Left().Level())
+		s.Require().Equal(60, root.Right().Value())
+		s.Require().True(root.Right().IsRight())
+		s.Require().Equal(2, root.Right().Right().Level())
+		s.Require().Equal(2, s.bst.Height())
+	})
+
+	s.Run("operations keep working afterwards", func() {
+		bst := NewBST[int]()
+		for i := 1; i <= 15; i++ {
+			bst.Insert(node.ID(uint64(i)), i)
+		}
+		bst.Rebalance()
+
+		s.Require().True(bst.Delete(8))
+		s.Require().True(bst.Insert(node.ID(100), 100))
+		s.Require().Equal(15, bst.Size())
+		s.Require().Nil(bst.Search(8))
+		s.Require().NotNil(bst.Search(100))
+	})
+
+	s.Run("empty tree", func() {
+		bst := NewBST[int]()
+		bst.Rebalance()
+		s.Require().Nil(bst.Root())
+		s.Require().Equal(0, bst.Size())
+	})
+}
+
+func (s *BSTTestSuite) TestRange() {
+	testCases := []struct {
+		name     string
+		low      int
+		high     int
+		expected []int
+	}{
+		{name: "whole tree", low: 0, high: 100, expected: []int{20, 30, 40, 50, 60, 70, 80}},
+		{name: "inclusive bounds", low: 30, high: 60, expected: []int{30, 40, 50, 60}},
+		{name: "bounds between values", low: 35, high: 65, expected: []int{40, 50, 60}},
+		{name: "single value", low: 70, high: 70, expected: []int{70}},
+		{name: "left subtree only", low: 0, high: 45, expected: []int{20, 30, 40}},
+		{name: "right subtree only", low: 55, high: 100, expected: []int{60, 70, 80}},
+		{name: "no values in range", low: 41, high: 49, expected: nil},
+		{name: "inverted bounds", low: 60, high: 30, expected: nil},
+	}
+
+	s.buildTree([]int{50, 30, 70, 20, 40, 60, 80})
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var got []int
+			s.bst.Range(tc.low, tc.high, func(bn *BinaryNode[int]) bool {
+				got = append(got, bn.Value())
+				return true
+			})
+			assert.Equal(s.T(), tc.expected, got)
+		})
+	}
+}
+
+func (s *BSTTestSuite) TestRangePrunesAndStopsEarly() {
+	s.buildTree([]int{50, 30, 70, 20, 40, 60, 80})
+
+	s.Run("early stop", func() {
+		var got []int
+		s.bst.Range(20, 80, func(bn *BinaryNode[int]) bool {
+			got = append(got, bn.Value())
+			return len(got) < 3
+		})
+		assert.Equal(s.T(), []int{20, 30, 40}, got)
+	})
+
+	s.Run("visits only in-range nodes", func() {
+		calls := 0
+		s.bst.Range(60, 70, func(bn *BinaryNode[int]) bool {
+			calls++
+			return true
+		})
+		assert.Equal(s.T(), 2, calls)
+	})
+
+	s.Run("empty tree and nil visitor", func() {
+		empty := NewBST[int]()
+		empty.Range(0, 100, func(*BinaryNode[int]) bool {
+			s.Fail("visit must not be called on an empty tree")
+			return true
+		})
+		s.bst.Range(0, 100, nil)
+	})
+}
+
+func (s *BSTTestSuite) TestKthSmallestLargest() {
+	sorted := []int{20, 30, 40, 50, 60, 70, 80}
+	s.buildTree([]int{50, 30, 70, 20, 40, 60, 80})
+
+	for k := 1; k <= len(sorted); k++ {
+		smallest, ok := s.bst.KthSmallest(k)
+		s.Require().True(ok)
+		s.Require().Equal(sorted[k-1], smallest.Value())
+
+		largest, ok := s.bst.KthLargest(k)
+		s.Require().True(ok)
+		s.Require().Equal(sorted[len(sorted)-k], largest.Value())
+	}
+
+	for _, k := range []int{-1, 0, len(sorted) + 1} {
+		n, ok := s.bst.KthSmallest(k)
+		s.Require().False(ok)
+		s.Require().Nil(n)
+
+		n, ok = s.bst.KthLargest(k)
+		s.Require().False(ok)
+		s.Require().Nil(n)
+	}
+}
+
+func (s *BSTTestSuite) TestKthSmallestEmptyTree() {
+	n, ok := s.bst.KthSmallest(1)
+	s.Require().False(ok)
+	s.Require().Nil(n)
+
+	n, ok = s.bst.KthLargest(1)
+	s.Require().False(ok)
+	s.Require().Nil(n)
+}
+
+func (s *BSTTestSuite) TestIter() {
+	s.buildTree([]int{50, 30, 70, 20, 40, 60, 80})
+
+	s.Run("ascending", func() {
+		var got []int
+		for n := range s.bst.Iter() {
+			got = append(got, n.Value())
+		}
+		assert.Equal(s.T(), []int{20, 30, 40, 50, 60, 70, 80}, got)
+	})
+
+	s.Run("descending", func() {
+		var got []int
+		for n := range s.bst.IterReverse() {
+			got = append(got, n.Value())
+		}
+		assert.Equal(s.T(), []int{80, 70, 60, 50, 40, 30, 20}, got)
+	})
+
+	s.Run("break stops iteration", func() {
+		var got []int
+		for n := range s.bst.Iter() {
+			if n.Value() > 40 {
+				break
+			}
+			got = append(got, n.Value())
+		}
+		assert.Equal(s.T(), []int{20, 30, 40}, got)
+
+		got = got[:0]
+		for n := range s.bst.IterReverse() {
+			got = append(got, n.Value())
+			if len(got) == 2 {
+				break
+			}
+		}
+		assert.Equal(s.T(), []int{80, 70}, got)
+	})
+
+	s.Run("matches InOrder", func() {
+		var expected []*BinaryNode[int]
+		s.bst.InOrder(func(n *BinaryNode[int]) {
+			expected = append(expected, n)
+		})
+
+		var got []*BinaryNode[int]
+		for n := range s.bst.Iter() {
+			got = append(got, n)
+		}
+		assert.Equal(s.T(), expected, got)
+	})
+}
+
+func (s *BSTTestSuite) TestIterEmptyTree() {
+	for range s.bst.Iter() {
+		s.Fail("Iter must not yield on an empty tree")
+	}
+	for range s.bst.IterReverse() {
+		s.Fail("IterReverse must not yield on an empty tree")
+	}
+}
+
+func (s *BSTTestSuite) TestIsValid() {
+	s.Run("empty tree", func() {
+		assert.True(s.T(), NewBST[int]().IsValid())
+	})
+
+	s.Run("after inserts and deletes", func() {
+		bst := NewBST[int]()
+		for i, v := range []int{50, 30, 70, 20, 40, 60, 80, 35, 45, 65} {
+			bst.Insert(node.ID(uint64(i+1)), v)
+		}
+		assert.True(s.T(), bst.IsValid())
+
+		for _, v := range []int{30, 50, 20, 80} {
+			s.Require().True(bst.Delete(v))
+			assert.True(s.T(), bst.IsValid())
+		}
+	})
+
+	s.Run("left child out of order", func() {
+		bst := NewBST[int]()
+		for i, v := range []int{50, 30, 70} {
+			bst.Insert(node.ID(uint64(i+1)), v)
+		}
+		bst.Root().Left().WithValue(55)
+		assert.False(s.T(), bst.IsValid())
+	})
+
+	s.Run("deep violation against an ancestor", func() {
+		bst := NewBST[int]()
+		for i, v := range []int{50, 30, 70, 20, 40} {
+			bst.Insert(node.ID(uint64(i+1)), v)
+		}
+		// 40 -> 60 keeps the local parent/child order but breaks the root bound.
+		bst.Root().Left().Right().WithValue(60)
+		assert.False(s.T(), bst.IsValid())
+	})
+
+	s.Run("duplicate value", func() {
+		bst := NewBST[int]()
+		for i, v := range []int{50, 30, 70} {
+			bst.Insert(node.ID(uint64(i+1)), v)
+		}
+		bst.Root().Right().WithValue(50)
+		assert.False(s.T(), bst.IsValid())
+	})
+
+	s.Run("size mismatch", func() {
+		bst := NewBST[int]()
+		for i, v := range []int{50, 30, 70} {
+			bst.Insert(node.ID(uint64(i+1)), v)
+		}
+		bst.Root().WithLeft(nil)
+		assert.False(s.T(), bst.IsValid())
+	})
+}
+
+func (s *BSTTestSuite) TestClone() {
+	s.buildTree([]int{50, 30, 70, 20, 40, 60, 80})
+
+	clone := s.bst.Clone()
+	s.Require().Equal(s.bst.Size(), clone.Size())
+	s.Require().True(clone.IsValid())
+
+	original := make([]*BinaryNode[int], 0, s.bst.Size())
+	for n := range s.bst.Iter() {
+		original = append(original, n)
+	}
+
+	i := 0
+	for n := range clone.Iter() {
+		src := original[i]
+		s.Require().NotSame(src, n)
+		s.Require().NotSame(src.Node, n.Node)
+		s.Require().Equal(src.ID(), n.ID())
+		s.Require().Equal(src.Value(), n.Value())
+		s.Require().Equal(src.Level(), n.Level())
+		s.Require().Equal(src.IsRoot(), n.IsRoot())
+		s.Require().Equal(src.IsLeft(), n.IsLeft())
+		i++
+	}
+	s.Require().Equal(len(original), i)
+
+	s.Run("mutations on the clone are isolated", func() {
+		s.Require().True(clone.Delete(50))
+		s.Require().True(clone.Insert(node.ID(100), 55))
+
+		found := s.bst.Search(50)
+		s.Require().NotNil(found)
+		s.Require().Same(s.bst.Root(), found)
+		s.Require().Nil(s.bst.Search(55))
+		s.Require().Equal(7, s.bst.Size())
+		s.Require().True(s.bst.IsValid())
+
+		s.Require().Nil(clone.Search(50))
+		s.Require().Equal(7, clone.Size())
+		s.Require().True(clone.IsValid())
+	})
+
+	s.Run("empty tree", func() {
+		empty := NewBST[int]().Clone()
+		s.Require().True(empty.IsEmpty())
+		s.Require().Nil(empty.Root())
+	})
+}
+
 // Helper function to create int pointer
 func intPtr(v int) *int {
 	return &v
@@ -2,7 +2,9 @@ package tree
 
 import (
 	"cmp"
+	"fmt"
 	"iter"
+	"slices"
 )
 
 const (
@@ -27,6 +29,8 @@ type (
 		entries  []BTreeEntry[K, V]
 		children []*btreeNode[K, V]
 		leaf     bool
+		// count is the number of entries in the subtree rooted at this node.
+		count int
 	}
 
 	// BTree is a self-balancing tree data structure that maintains sorted data
@@ -72,6 +76,96 @@ func NewBTree[K cmp.Ordered, V any](minDegree int, opts ...BTreeOption[K, V]) *B
 	return t
 }
 
+// BulkLoad builds a B-tree bottom-up from entries that are already sorted by key
+// in strictly ascending order. Each level is split into evenly filled nodes with
+// the separating entries promoted to the level above, so the result is balanced
+// and satisfies every B-tree invariant without a single split.
+// If minDegree < 2, DefaultMinDegree (2) is used.
+//
+// Returns ErrNotStrictlyAscending if a key is not greater than its predecessor
+// (this includes duplicate keys).
+//
+// Time complexity: O(n)
+//
+// Example:
+//
+//	entries := []BTreeEntry[uint64, string]{{Key: 1, Value: "a"}, {Key: 2, Value: "b"}}
+//	tree, err := BulkLoad(entries, 3)
+func BulkLoad[K cmp.Ordered, V any](entries []BTreeEntry[K, V], minDegree int) (*BTree[K, V], error) {
+	for i := 1; i < len(entries); i++ {
+		if !(entries[i-1].Key < entries[i].Key) {
+			return nil, fmt.Errorf("entry %d [%v] after [%v]: %w", i, entries[i].Key, entries[i-1].Key, ErrNotStrictlyAscending)
+		}
+	}
+
+	t := NewBTree[K, V](minDegree)
+	n := len(entries)
+	if n == 0 {
+		return t, nil
+	}
+
+	// Leaf level: k leaves separated by k-1 entries, each leaf holding
+	// between t-1 and 2t-1 entries.
+	maxChildren := 2 * t.minDegree
+	k := (n + maxChildren) / maxChildren
+	base, extra := (n-k+1)/k, (n-k+1)%k
+	nodes := make([]*btreeNode[K, V], 0, k)
+	separators := make([]BTreeEntry[K, V], 0, k-1)
+	pos := 0
+	for i := 0; i < k; i++ {
+		size := base
+		if i < extra {
+			size++
+		}
+		leaf := newNode[K, V](t.minDegree, true)
+		leaf.entries = append(leaf.entries, entries[pos:pos+size]...)
+		leaf.count = size
+		nodes = append(nodes, leaf)
+		pos += size
+		if i < k-1 {
+			separators = append(separators, entries[pos])
+			pos++
+		}
+	}
+
+	// Internal levels: group children into parents of t to 2t children. The
+	// separators between children of one parent become its entries, the ones
+	// between parents move up a level.
+	for len(nodes) > 1 {
+		c := len(nodes)
+		p := (c + maxChildren - 1) / maxChildren
+		base, extra := c/p, c%p
+		parents := make([]*btreeNode[K, V], 0, p)
+		parentSeparators := make([]BTreeEntry[K, V], 0, p-1)
+		child := 0
+		for i := 0; i < p; i++ {
+			size := base
+			if i < extra {
+				size++
+			}
+			parent := newNode[K, V](t.minDegree, false)
+			for j := 0; j < size; j++ {
+				parent.children = append(parent.children, nodes[child])
+				if j < size-1 {
+					parent.entries = append(parent.entries, separators[child])
+				}
+				child++
+			}
+			parent.recount()
+			parents = append(parents, parent)
+			if i < p-1 {
+				parentSeparators = append(parentSeparators, separators[child-1])
+			}
+		}
+		nodes, separators = parents, parentSeparators
+	}
+
+	t.root = nodes[0]
+	t.size = n
+
+	return t, nil
+}
+
 // newNode creates a new B-tree node.
 func newNode[K cmp.Ordered, V any](minDegree int, leaf bool) *btreeNode[K, V] {
 	return &btreeNode[K, V]{
@@ -81,6 +175,15 @@ func newNode[K cmp.Ordered, V any](minDegree int, leaf bool) *btreeNode[K, V] {
 	}
 }
 
+// recount recomputes the subtree entry count from the node's entries and children.
+func (node *btreeNode[K, V]) recount() {
+	count := len(node.entries)
+	for _, child := range node.children {
+		count += child.count
+	}
+	node.count = count
+}
+
 // Size returns the number of entries in the B-tree.
 func (t *BTree[K, V]) Size() int {
 	return t.size
@@ -113,12 +216,40 @@ func (t *BTree[K, V]) Height() int {
 	return height
 }
 
+// LevelEntries returns the entries of every node at the given depth, one slice
+// per node in left-to-right order. The root is level 0 and the leaves are at
+// level Height()-1. Returns an empty slice if level is out of range.
+//
+// This is mainly a diagnostics aid for inspecting how keys are distributed
+// across nodes after splits and merges.
+func (t *BTree[K, V]) LevelEntries(level int) [][]BTreeEntry[K, V] {
+	if level < 0 || level >= t.Height() {
+		return [][]BTreeEntry[K, V]{}
+	}
+
+	nodes := []*btreeNode[K, V]{t.root}
+	for range level {
+		next := make([]*btreeNode[K, V], 0, len(nodes)*2*t.minDegree)
+		for _, node := range nodes {
+			next = append(next, node.children...)
+		}
+		nodes = next
+	}
+
+	levelEntries := make([][]BTreeEntry[K, V], len(nodes))
+	for i, node := range nodes {
+		levelEntries[i] = slices.Clone(node.entries)
+	}
+	return levelEntries
+}
+
 // Insert adds a key-value pair to the B-tree.
 // If the key already exists, the value is updated.
 func (t *BTree[K, V]) Insert(key K, value V) {
 	if t.root == nil {
 		t.root = newNode[K, V](t.minDegree, true)
 		t.root.entries = append(t.root.entries, BTreeEntry[K, V]{Key: key, Value: value})
+		t.root.count = 1
 		t.size++
 		return
 	}
@@ -132,6 +263,7 @@ func (t *BTree[K, V]) Insert(key K, value V) {
 	if len(t.root.entries) == 2*t.minDegree-1 {
 		newRoot := newNode[K, V](t.minDegree, false)
 		newRoot.children = append(newRoot.children, t.root)
+		newRoot.count = t.root.count
 		t.splitChild(newRoot, 0)
 		t.root = newRoot
 	}
@@ -140,6 +272,73 @@ func (t *BTree[K, V]) Insert(key K, value V) {
 	t.size++
 }
 
+// Upsert sets the value of key to fn(current, true) if the key exists, or inserts
+// it with fn(zero, false) otherwise. Unlike Search followed by Insert, the tree is
+// walked only once: full nodes are split on the way down, so a missing key can be
+// inserted into the leaf the walk ends in. fn is called exactly once; a nil fn is a no-op.
+//
+// Example:
+//
+//	counts.Upsert(offset, func(old int, _ bool) int { return old + 1 })
+func (t *BTree[K, V]) Upsert(key K, fn func(old V, existed bool) V) {
+	if fn == nil {
+		return
+	}
+
+	var zero V
+	if t.root == nil {
+		t.Insert(key, fn(zero, false))
+		return
+	}
+
+	// If root is full, split it
+	if len(t.root.entries) == 2*t.minDegree-1 {
+		newRoot := newNode[K, V](t.minDegree, false)
+		newRoot.children = append(newRoot.children, t.root)
+		newRoot.count = t.root.count
+		t.splitChild(newRoot, 0)
+		t.root = newRoot
+	}
+
+	path := make([]*btreeNode[K, V], 0, t.Height())
+	node := t.root
+	for {
+		i := 0
+		for i < len(node.entries) && key > node.entries[i].Key {
+			i++
+		}
+
+		if i < len(node.entries) && key == node.entries[i].Key {
+			node.entries[i].Value = fn(node.entries[i].Value, true)
+			return
+		}
+
+		path = append(path, node)
+		if node.leaf {
+			node.entries = slices.Insert(node.entries, i, BTreeEntry[K, V]{Key: key, Value: fn(zero, false)})
+			for _, visited := range path {
+				visited.count++
+			}
+			t.size++
+			return
+		}
+
+		// Split child if full; the promoted median may be the key itself
+		if len(node.children[i].entries) == 2*t.minDegree-1 {
+			t.splitChild(node, i)
+			if key == node.entries[i].Key {
+				node.entries[i].Value = fn(node.entries[i].Value, true)
+				return
+			}
+			if key > node.entries[i].Key {
+				i++
+			}
+		}
+
+		node = node.children[i]
+	}
+}
+
 // update attempts to update an existing key's value.
 // Returns true if key was found and updated, false otherwise.
 func (t *BTree[K, V]) update(node *btreeNode[K, V], key K, value V) bool {
@@ -189,11 +388,16 @@ func (t *BTree[K, V]) splitChild(parent *btreeNode[K, V], i int) {
 	parent.entries = append(parent.entries, BTreeEntry[K, V]{})
 	copy(parent.entries[i+1:], parent.entries[i:])
 	parent.entries[i] = medianEntry
+
+	// The parent's subtree total is unchanged, only the halves need recounting
+	fullChild.recount()
+	newChild.recount()
 }
 
 // insertNonFull inserts a key-value pair into a non-full node.
 func (t *BTree[K, V]) insertNonFull(node *btreeNode[K, V], key K, value V) {
 	i := len(node.entries) - 1
+	node.count++
 
 	if node.leaf {
 		// Find position and insert
@@ -258,6 +462,96 @@ func (t *BTree[K, V]) Contains(key K) bool {
 	return found
 }
 
+// Rank returns the number of entries with a key strictly less than key.
+//
+// Every node tracks the number of entries in its subtree, so the rank is
+// computed along a single root-to-leaf path.
+//
+// Time complexity: O(t · height) where t is the minimum degree
+func (t *BTree[K, V]) Rank(key K) int {
+	return t.rank(key, false)
+}
+
+// CountRange returns the number of entries with keys in [from, to].
+// Returns 0 if from > to.
+//
+// Time complexity: O(t · height) where t is the minimum degree
+func (t *BTree[K, V]) CountRange(from, to K) int {
+	if from > to {
+		return 0
+	}
+
+	return t.rank(to, true) - t.rank(from, false)
+}
+
+// Select returns the k-th smallest entry (0-indexed).
+// Returns a zero entry and false if k is out of range.
+//
+// Like Rank it uses the subtree counts to descend along a single path.
+//
+// Time complexity: O(t · height) where t is the minimum degree
+func (t *BTree[K, V]) Select(k int) (BTreeEntry[K, V], bool) {
+	if k < 0 || k >= t.size {
+		return BTreeEntry[K, V]{}, false
+	}
+
+	node := t.root
+	for node != nil {
+		if node.leaf {
+			return node.entries[k], true
+		}
+
+		i := 0
+		for ; i < len(node.entries); i++ {
+			if k < node.children[i].count {
+				break
+			}
+			k -= node.children[i].count
+			if k == 0 {
+				return node.entries[i], true
+			}
+			k--
+		}
+		node = node.children[i]
+	}
+
+	return BTreeEntry[K, V]{}, false
+}
+
+// rank counts the entries with a key less than key, or less than or equal to
+// key when inclusive is set.
+func (t *BTree[K, V]) rank(key K, inclusive bool) int {
+	rank := 0
+	node := t.root
+	for node != nil {
+		i := 0
+		for i < len(node.entries) && node.entries[i].Key < key {
+			if !node.leaf {
+				rank += node.children[i].count
+			}
+			rank++
+			i++
+		}
+
+		if i < len(node.entries) && node.entries[i].Key == key {
+			if !node.leaf {
+				rank += node.children[i].count
+			}
+			if inclusive {
+				rank++
+			}
+			return rank
+		}
+
+		if node.leaf {
+			return rank
+		}
+		node = node.children[i]
+	}
+
+	return rank
+}
+
 // Delete removes a key from the B-tree.
 // Returns true if the key was found and deleted, false otherwise.
 func (t *BTree[K, V]) Delete(key K) bool {
@@ -265,24 +559,92 @@ func (t *BTree[K, V]) Delete(key K) bool {
 		return false
 	}
 
+	// Topping up children on the way down can merge the root's last two
+	// children even when the key is absent, so shrink the root either way.
 	deleted := t.delete(t.root, key)
 	if deleted {
 		t.size--
+	}
+	t.shrinkRoot()
 
-		// If root has no entries and has a child, make that child the new root
-		if len(t.root.entries) == 0 {
-			if t.root.leaf {
-				t.root = nil
-			} else {
-				t.root = t.root.children[0]
-			}
+	return deleted
+}
+
+// PopMin removes and returns the entry with the smallest key.
+// Unlike Min followed by Delete, it descends the tree only once.
+// Returns a zero entry and false if the tree is empty.
+func (t *BTree[K, V]) PopMin() (BTreeEntry[K, V], bool) {
+	return t.pop(false)
+}
+
+// PopMax removes and returns the entry with the largest key.
+// Unlike Max followed by Delete, it descends the tree only once.
+// Returns a zero entry and false if the tree is empty.
+func (t *BTree[K, V]) PopMax() (BTreeEntry[K, V], bool) {
+	return t.pop(true)
+}
+
+func (t *BTree[K, V]) pop(last bool) (BTreeEntry[K, V], bool) {
+	if t.root == nil {
+		return BTreeEntry[K, V]{}, false
+	}
+
+	entry := t.popEdge(t.root, last)
+	t.size--
+	t.shrinkRoot()
+
+	return entry, true
+}
+
+// popEdge removes and returns the first (or last) entry of the subtree rooted at
+// node, topping up each child on the way down like deleteFromChild does.
+func (t *BTree[K, V]) popEdge(node *btreeNode[K, V], last bool) BTreeEntry[K, V] {
+	defer node.recount()
+
+	if node.leaf {
+		if last {
+			entry := node.entries[len(node.entries)-1]
+			node.entries = node.entries[:len(node.entries)-1]
+			return entry
 		}
+		entry := node.entries[0]
+		node.entries = append(node.entries[:0], node.entries[1:]...)
+		return entry
 	}
 
-	return deleted
+	i := 0
+	if last {
+		i = len(node.children) - 1
+	}
+	i = t.fill(node, i)
+
+	return t.popEdge(node.children[i], last)
+}
+
+// shrinkRoot drops an empty root after a delete or pop: the tree becomes empty if the
+// root was a leaf, otherwise its only child becomes the new root.
+func (t *BTree[K, V]) shrinkRoot() {
+	if len(t.root.entries) > 0 {
+		return
+	}
+
+	if t.root.leaf {
+		t.root = nil
+	} else {
+		t.root = t.root.children[0]
+	}
 }
 
 func (t *BTree[K, V]) delete(node *btreeNode[K, V], key K) bool {
+	deleted := t.deleteEntry(node, key)
+	if deleted {
+		node.recount()
+	}
+
+	return deleted
+}
+
+func (t *BTree[K, V]) deleteEntry(node *btreeNode[K, V], key K) bool {
 	i := 0
 	for i < len(node.entries) && key > node.entries[i].Key {
 		i++
@@ -336,6 +698,14 @@ func (t *BTree[K, V]) deleteFromInternal(node *btreeNode[K, V], i int) bool {
 
 // deleteFromChild handles deletion when key might be in a child.
 func (t *BTree[K, V]) deleteFromChild(node *btreeNode[K, V], i int, key K) bool {
+	i = t.fill(node, i)
+	return t.delete(node.children[i], key)
+}
+
+// fill ensures child[i] has at least t keys before descending into it, borrowing
+// from a sibling or merging with one. Returns the index of the child to descend
+// into, which moves left by one when child[i] is merged into its left sibling.
+func (t *BTree[K, V]) fill(node *btreeNode[K, V], i int) int {
 	minDeg := t.minDegree
 	child := node.children[i]
 
@@ -358,7 +728,7 @@ func (t *BTree[K, V]) deleteFromChild(node *btreeNode[K, V], i int, key K) bool
 		}
 	}
 
-	return t.delete(node.children[i], key)
+	return i
 }
 
 // getPredecessor returns the predecessor (largest key in left subtree).
@@ -394,6 +764,9 @@ func (t *BTree[K, V]) borrowFromLeft(parent *btreeNode[K, V], i int) {
 		child.children = append([]*btreeNode[K, V]{leftSibling.children[len(leftSibling.children)-1]}, child.children...)
 		leftSibling.children = leftSibling.children[:len(leftSibling.children)-1]
 	}
+
+	child.recount()
+	leftSibling.recount()
 }
 
 // borrowFromRight borrows an entry from the right sibling.
@@ -413,6 +786,9 @@ func (t *BTree[K, V]) borrowFromRight(parent *btreeNode[K, V], i int) {
 		child.children = append(child.children, rightSibling.children[0])
 		rightSibling.children = rightSibling.children[1:]
 	}
+
+	child.recount()
+	rightSibling.recount()
 }
 
 // merge merges child[i] with child[i+1].
@@ -436,6 +812,8 @@ func (t *BTree[K, V]) merge(parent *btreeNode[K, V], i int) {
 
 	// Remove right child from parent
 	parent.children = append(parent.children[:i+1], parent.children[i+2:]...)
+
+	left.recount()
 }
 
 // Min returns the minimum key-value pair in the B-tree.
@@ -553,12 +931,117 @@ func (t *BTree[K, V]) inOrderTraverse(node *btreeNode[K, V], yield func(BTreeEnt
 	return true
 }
 
+// AllReverse returns an iterator over all entries in descending key order.
+func (t *BTree[K, V]) AllReverse() iter.Seq[BTreeEntry[K, V]] {
+	return func(yield func(BTreeEntry[K, V]) bool) {
+		if t.root == nil {
+			return
+		}
+		t.reverseOrderTraverse(t.root, yield)
+	}
+}
+
+func (t *BTree[K, V]) reverseOrderTraverse(node *btreeNode[K, V], yield func(BTreeEntry[K, V]) bool) bool {
+	for i := len(node.entries) - 1; i >= 0; i-- {
+		// Visit right child if not a leaf
+		if !node.leaf {
+			if !t.reverseOrderTraverse(node.children[i+1], yield) {
+				return false
+			}
+		}
+
+		// Yield the current entry
+		if !yield(node.entries[i]) {
+			return false
+		}
+	}
+
+	// Visit leftmost child if not a leaf
+	if !node.leaf {
+		return t.reverseOrderTraverse(node.children[0], yield)
+	}
+
+	return true
+}
+
+// RangeReverse returns an iterator over all entries with keys in [from, to].
+// The entries are yielded in descending key order.
+func (t *BTree[K, V]) RangeReverse(from, to K) iter.Seq[BTreeEntry[K, V]] {
+	return func(yield func(BTreeEntry[K, V]) bool) {
+		if t.root == nil || from > to {
+			return
+		}
+		t.rangeReverseTraverse(t.root, from, to, yield)
+	}
+}
+
+func (t *BTree[K, V]) rangeReverseTraverse(node *btreeNode[K, V], from, to K, yield func(BTreeEntry[K, V]) bool) bool {
+	i := len(node.entries) - 1
+	for i >= 0 && node.entries[i].Key > to {
+		i--
+	}
+
+	for i >= 0 {
+		// Visit right child if not a leaf
+		if !node.leaf {
+			if !t.rangeReverseTraverse(node.children[i+1], from, to, yield) {
+				return false
+			}
+		}
+
+		// Check if we've passed the lower bound
+		if node.entries[i].Key < from {
+			return true
+		}
+
+		// Yield the current entry
+		if !yield(node.entries[i]) {
+			return false
+		}
+
+		i--
+	}
+
+	// Visit leftmost child if not a leaf
+	if !node.leaf {
+		return t.rangeReverseTraverse(node.children[0], from, to, yield)
+	}
+
+	return true
+}
+
 // Clear removes all entries from the B-tree.
 func (t *BTree[K, V]) Clear() {
 	t.root = nil
 	t.size = 0
 }
 
+// Clone returns a deep copy of the B-tree. Every node, including its entries and
+// children slices, is independently allocated, so inserts and deletes on the copy
+// never affect the original. Values are copied as-is (shallow for reference types).
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	c := &BTree[K, V]{
+		minDegree: t.minDegree,
+		size:      t.size,
+	}
+	if t.root != nil {
+		c.root = t.cloneNode(t.root)
+	}
+
+	return c
+}
+
+func (t *BTree[K, V]) cloneNode(node *btreeNode[K, V]) *btreeNode[K, V] {
+	c := newNode[K, V](t.minDegree, node.leaf)
+	c.entries = append(c.entries, node.entries...)
+	c.count = node.count
+	for _, child := range node.children {
+		c.children = append(c.children, t.cloneNode(child))
+	}
+
+	return c
+}
+
 // Floor returns the largest entry with a key <= the given key.
 // Returns zero values and false if no such entry exists.
 func (t *BTree[K, V]) Floor(key K) (floorKey K, floorValue V, found bool) {
@@ -661,6 +1144,58 @@ func (t *BTree[K, V]) ceiling(node *btreeNode[K, V], key K) (BTreeEntry[K, V], b
 	return t.ceiling(node.children[i], key)
 }
 
+// FirstN returns up to n entries with the smallest keys, in ascending key order.
+// The traversal stops as soon as n entries are collected. Returns an empty slice
+// if n <= 0.
+func (t *BTree[K, V]) FirstN(n int) []BTreeEntry[K, V] {
+	return t.takeN(t.All(), n)
+}
+
+// LastN returns up to n entries with the largest keys, in ascending key order.
+// The traversal stops as soon as n entries are collected. Returns an empty slice
+// if n <= 0.
+func (t *BTree[K, V]) LastN(n int) []BTreeEntry[K, V] {
+	entries := t.takeN(t.AllReverse(), n)
+	slices.Reverse(entries)
+	return entries
+}
+
+// takeN collects up to n entries from seq.
+func (t *BTree[K, V]) takeN(seq iter.Seq[BTreeEntry[K, V]], n int) []BTreeEntry[K, V] {
+	entries := make([]BTreeEntry[K, V], 0, max(min(n, t.size), 0))
+	if n <= 0 {
+		return entries
+	}
+
+	for entry := range seq {
+		entries = append(entries, entry)
+		if len(entries) == n {
+			break
+		}
+	}
+	return entries
+}
+
+// FloorEntry returns the largest entry with a key <= the given key.
+// Returns a zero entry and false if no such entry exists.
+func (t *BTree[K, V]) FloorEntry(key K) (BTreeEntry[K, V], bool) {
+	if t.root == nil {
+		return BTreeEntry[K, V]{}, false
+	}
+
+	return t.floor(t.root, key)
+}
+
+// CeilingEntry returns the smallest entry with a key >= the given key.
+// Returns a zero entry and false if no such entry exists.
+func (t *BTree[K, V]) CeilingEntry(key K) (BTreeEntry[K, V], bool) {
+	if t.root == nil {
+		return BTreeEntry[K, V]{}, false
+	}
+
+	return t.ceiling(t.root, key)
+}
+
 // Keys returns all keys in ascending order.
 func (t *BTree[K, V]) Keys() []K {
 	keys := make([]K, 0, t.size)
@@ -678,3 +1213,29 @@ func (t *BTree[K, V]) Values() []V {
 	}
 	return values
 }
+
+// KeysSeq returns an iterator over all keys in ascending order.
+// Unlike Keys it does not allocate a slice, and the traversal stops as soon as
+// the caller breaks out of the loop.
+func (t *BTree[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for entry := range t.All() {
+			if !yield(entry.Key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over all values in key-ascending order.
+// Unlike Values it does not allocate a slice, and the traversal stops as soon as
+// the caller breaks out of the loop.
+func (t *BTree[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for entry := range t.All() {
+			if !yield(entry.Value) {
+				return
+			}
+		}
+	}
+}
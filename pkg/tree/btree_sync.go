@@ -0,0 +1,262 @@
+package tree
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// SyncBTree is a thread-safe wrapper around BTree for concurrent message indexing.
+//
+// Lookups acquire a shared read lock while mutations acquire an exclusive write
+// lock, allowing many concurrent readers and occasional writers.
+//
+// The iterators (All, AllReverse, Range, RangeReverse, KeysSeq, ValuesSeq) copy
+// the matching entries into a snapshot under the read lock and yield from that
+// snapshot after the lock is released. Iteration therefore never blocks writers,
+// callers may mutate the tree from inside the loop, and changes made during
+// iteration are not observed.
+type SyncBTree[K cmp.Ordered, V any] struct {
+	mu sync.RWMutex
+	t  *BTree[K, V]
+}
+
+// NewSyncBTree wraps t for concurrent use. If t is nil a new empty BTree with
+// DefaultMinDegree is created. The caller must not access t directly after wrapping it.
+func NewSyncBTree[K cmp.Ordered, V any](t *BTree[K, V]) *SyncBTree[K, V] {
+	if t == nil {
+		t = NewBTree[K, V](DefaultMinDegree)
+	}
+	return &SyncBTree[K, V]{t: t}
+}
+
+// Size returns the number of entries in the B-tree.
+func (st *SyncBTree[K, V]) Size() int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Size()
+}
+
+// IsEmpty returns true if the B-tree contains no entries.
+func (st *SyncBTree[K, V]) IsEmpty() bool {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.IsEmpty()
+}
+
+// Height returns the height of the B-tree.
+func (st *SyncBTree[K, V]) Height() int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Height()
+}
+
+// LevelEntries returns the entries of every node at the given depth. See BTree.LevelEntries.
+func (st *SyncBTree[K, V]) LevelEntries(level int) [][]BTreeEntry[K, V] {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.LevelEntries(level)
+}
+
+// Insert adds or updates a key-value pair under the write lock. See BTree.Insert.
+func (st *SyncBTree[K, V]) Insert(key K, value V) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.t.Insert(key, value)
+}
+
+// Upsert updates or inserts key under the write lock, so the read-modify-write
+// is atomic with respect to other callers. fn must not call back into st.
+// See BTree.Upsert.
+func (st *SyncBTree[K, V]) Upsert(key K, fn func(old V, existed bool) V) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.t.Upsert(key, fn)
+}
+
+// Delete removes a key under the write lock. See BTree.Delete.
+func (st *SyncBTree[K, V]) Delete(key K) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.t.Delete(key)
+}
+
+// PopMin removes and returns the smallest entry under the write lock. See BTree.PopMin.
+func (st *SyncBTree[K, V]) PopMin() (BTreeEntry[K, V], bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.t.PopMin()
+}
+
+// PopMax removes and returns the largest entry under the write lock. See BTree.PopMax.
+func (st *SyncBTree[K, V]) PopMax() (BTreeEntry[K, V], bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.t.PopMax()
+}
+
+// Clear removes all entries under the write lock.
+func (st *SyncBTree[K, V]) Clear() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.t.Clear()
+}
+
+// Search finds the value associated with key. See BTree.Search.
+func (st *SyncBTree[K, V]) Search(key K) (V, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Search(key)
+}
+
+// Contains returns true if the key exists in the B-tree.
+func (st *SyncBTree[K, V]) Contains(key K) bool {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Contains(key)
+}
+
+// Min returns the minimum key-value pair. See BTree.Min.
+func (st *SyncBTree[K, V]) Min() (key K, value V, found bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Min()
+}
+
+// Max returns the maximum key-value pair. See BTree.Max.
+func (st *SyncBTree[K, V]) Max() (key K, value V, found bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Max()
+}
+
+// Floor returns the largest entry with a key <= key. See BTree.Floor.
+func (st *SyncBTree[K, V]) Floor(key K) (floorKey K, floorValue V, found bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Floor(key)
+}
+
+// Ceiling returns the smallest entry with a key >= key. See BTree.Ceiling.
+func (st *SyncBTree[K, V]) Ceiling(key K) (ceilingKey K, ceilingValue V, found bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Ceiling(key)
+}
+
+// FloorEntry returns the largest entry with a key <= key. See BTree.FloorEntry.
+func (st *SyncBTree[K, V]) FloorEntry(key K) (BTreeEntry[K, V], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.FloorEntry(key)
+}
+
+// CeilingEntry returns the smallest entry with a key >= key. See BTree.CeilingEntry.
+func (st *SyncBTree[K, V]) CeilingEntry(key K) (BTreeEntry[K, V], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.CeilingEntry(key)
+}
+
+// FirstN returns up to n entries with the smallest keys. See BTree.FirstN.
+func (st *SyncBTree[K, V]) FirstN(n int) []BTreeEntry[K, V] {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.FirstN(n)
+}
+
+// LastN returns up to n entries with the largest keys. See BTree.LastN.
+func (st *SyncBTree[K, V]) LastN(n int) []BTreeEntry[K, V] {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.LastN(n)
+}
+
+// Rank returns the number of entries with a key strictly less than key. See BTree.Rank.
+func (st *SyncBTree[K, V]) Rank(key K) int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Rank(key)
+}
+
+// CountRange returns the number of entries with keys in [from, to]. See BTree.CountRange.
+func (st *SyncBTree[K, V]) CountRange(from, to K) int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.CountRange(from, to)
+}
+
+// Select returns the k-th smallest entry (0-indexed). See BTree.Select.
+func (st *SyncBTree[K, V]) Select(k int) (BTreeEntry[K, V], bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.t.Select(k)
+}
+
+// snapshot collects seq under the read lock and returns an iterator over the copy.
+func (st *SyncBTree[K, V]) snapshot(seq func(t *BTree[K, V]) iter.Seq[BTreeEntry[K, V]]) iter.Seq[BTreeEntry[K, V]] {
+	return func(yield func(BTreeEntry[K, V]) bool) {
+		st.mu.RLock()
+		entries := slices.Collect(seq(st.t))
+		st.mu.RUnlock()
+
+		for _, entry := range entries {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over a snapshot of all entries in ascending key order.
+func (st *SyncBTree[K, V]) All() iter.Seq[BTreeEntry[K, V]] {
+	return st.snapshot(func(t *BTree[K, V]) iter.Seq[BTreeEntry[K, V]] {
+		return t.All()
+	})
+}
+
+// AllReverse returns an iterator over a snapshot of all entries in descending key order.
+func (st *SyncBTree[K, V]) AllReverse() iter.Seq[BTreeEntry[K, V]] {
+	return st.snapshot(func(t *BTree[K, V]) iter.Seq[BTreeEntry[K, V]] {
+		return t.AllReverse()
+	})
+}
+
+// Range returns an iterator over a snapshot of the entries with keys in [from, to],
+// in ascending key order.
+func (st *SyncBTree[K, V]) Range(from, to K) iter.Seq[BTreeEntry[K, V]] {
+	return st.snapshot(func(t *BTree[K, V]) iter.Seq[BTreeEntry[K, V]] {
+		return t.Range(from, to)
+	})
+}
+
+// RangeReverse returns an iterator over a snapshot of the entries with keys in
+// [from, to], in descending key order.
+func (st *SyncBTree[K, V]) RangeReverse(from, to K) iter.Seq[BTreeEntry[K, V]] {
+	return st.snapshot(func(t *BTree[K, V]) iter.Seq[BTreeEntry[K, V]] {
+		return t.RangeReverse(from, to)
+	})
+}
+
+// KeysSeq returns an iterator over a snapshot of all keys in ascending order.
+func (st *SyncBTree[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for entry := range st.All() {
+			if !yield(entry.Key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over a snapshot of all values in key-ascending order.
+func (st *SyncBTree[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for entry := range st.All() {
+			if !yield(entry.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package tree
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SyncBTreeTestSuite struct {
+	suite.Suite
+}
+
+func TestSyncBTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(SyncBTreeTestSuite))
+}
+
+func (s *SyncBTreeTestSuite) TestNewSyncBTree_Nil() {
+	tree := NewSyncBTree[uint64, string](nil)
+
+	s.NotNil(tree)
+	s.True(tree.IsEmpty())
+	s.Equal(0, tree.Height())
+}
+
+func (s *SyncBTreeTestSuite) TestBasicOperations() {
+	tree := NewSyncBTree(NewBTree[uint64, string](3))
+
+	for i := uint64(1); i <= 10; i++ {
+		tree.Insert(i*10, "value")
+	}
+	s.Equal(10, tree.Size())
+	s.True(tree.Contains(50))
+
+	val, found := tree.Search(50)
+	s.True(found)
+	s.Equal("value", val)
+
+	key, _, found := tree.Min()
+	s.True(found)
+	s.Equal(uint64(10), key)
+
+	key, _, found = tree.Max()
+	s.True(found)
+	s.Equal(uint64(100), key)
+
+	key, _, found = tree.Floor(55)
+	s.True(found)
+	s.Equal(uint64(50), key)
+
+	key, _, found = tree.Ceiling(55)
+	s.True(found)
+	s.Equal(uint64(60), key)
+
+	floor, found := tree.FloorEntry(55)
+	s.True(found)
+	s.Equal(uint64(50), floor.Key)
+
+	ceiling, found := tree.CeilingEntry(55)
+	s.True(found)
+	s.Equal(uint64(60), ceiling.Key)
+
+	s.Equal(uint64(10), tree.FirstN(2)[0].Key)
+	s.Equal(uint64(100), tree.LastN(2)[1].Key)
+
+	s.Equal(4, tree.Rank(50))
+	s.Equal(3, tree.CountRange(20, 40))
+
+	entry, found := tree.Select(2)
+	s.True(found)
+	s.Equal(uint64(30), entry.Key)
+
+	s.True(tree.Delete(50))
+	s.False(tree.Contains(50))
+
+	entry, found = tree.PopMin()
+	s.True(found)
+	s.Equal(uint64(10), entry.Key)
+	entry, found = tree.PopMax()
+	s.True(found)
+	s.Equal(uint64(100), entry.Key)
+	s.False(tree.Contains(10))
+	s.False(tree.Contains(100))
+
+	tree.Clear()
+	s.True(tree.IsEmpty())
+}
+
+func (s *SyncBTreeTestSuite) TestIterators() {
+	tree := NewSyncBTree(NewBTree[int, int](2))
+	for i := 1; i <= 10; i++ {
+		tree.Insert(i, i*10)
+	}
+
+	keys := func(seq func(func(BTreeEntry[int, int]) bool)) []int {
+		var res []int
+		for entry := range seq {
+			res = append(res, entry.Key)
+		}
+		return res
+	}
+
+	s.Equal([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, keys(tree.All()))
+	s.Equal([]int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}, keys(tree.AllReverse()))
+	s.Equal([]int{3, 4, 5}, keys(tree.Range(3, 5)))
+	s.Equal([]int{5, 4, 3}, keys(tree.RangeReverse(3, 5)))
+	s.Equal([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, slices.Collect(tree.KeysSeq()))
+	s.Equal([]int{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}, slices.Collect(tree.ValuesSeq()))
+}
+
+func (s *SyncBTreeTestSuite) TestIterators_MutateDuringIteration() {
+	tree := NewSyncBTree(NewBTree[int, int](2))
+	for i := 1; i <= 10; i++ {
+		tree.Insert(i, i)
+	}
+
+	// Mutations inside the loop do not deadlock and are not observed by the snapshot
+	var seen []int
+	for entry := range tree.All() {
+		seen = append(seen, entry.Key)
+		tree.Delete(entry.Key)
+		tree.Insert(entry.Key+100, entry.Value)
+	}
+
+	s.Len(seen, 10)
+	s.Equal(10, tree.Size())
+	var keys []int
+	for entry := range tree.All() {
+		keys = append(keys, entry.Key)
+	}
+	s.Equal([]int{101, 102, 103, 104, 105, 106, 107, 108, 109, 110}, keys)
+}
+
+func (s *SyncBTreeTestSuite) TestConcurrentAccess() {
+	tree := NewSyncBTree(NewBTree[uint64, int](4))
+
+	const writers, perWriter = 8, 500
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				tree.Insert(uint64(w*perWriter+i), i)
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				tree.Contains(uint64(i))
+				tree.Rank(uint64(i))
+				for range tree.Range(0, 10) {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(writers*perWriter, tree.Size())
+	s.Equal(writers*perWriter, tree.CountRange(0, writers*perWriter))
+}
+
+func (s *SyncBTreeTestSuite) TestConcurrentUpsert() {
+	tree := NewSyncBTree(NewBTree[int, int](2))
+
+	const workers, perWorker, keys = 8, 1000, 10
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				tree.Upsert(i%keys, func(old int, _ bool) int { return old + 1 })
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(keys, tree.Size())
+	for k := 0; k < keys; k++ {
+		v, found := tree.Search(k)
+		s.True(found)
+		s.Equal(workers*perWorker/keys, v)
+	}
+}
@@ -1,6 +1,8 @@
 package tree
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -279,6 +281,112 @@ func (s *BTreeTestSuite) TestBTree_Delete_LargeDataset() {
 	}
 }
 
+func (s *BTreeTestSuite) TestBTree_PopMin_PopMax_Empty() {
+	tree := NewBTree[int, string](2)
+
+	entry, found := tree.PopMin()
+	s.False(found)
+	s.Equal(BTreeEntry[int, string]{}, entry)
+	entry, found = tree.PopMax()
+	s.False(found)
+	s.Equal(BTreeEntry[int, string]{}, entry)
+}
+
+func (s *BTreeTestSuite) TestBTree_PopMin_PopMax() {
+	tree := NewBTree[int, string](2)
+	for i := 1; i <= 5; i++ {
+		tree.Insert(i, fmt.Sprintf("v%d", i))
+	}
+
+	entry, found := tree.PopMin()
+	s.True(found)
+	s.Equal(BTreeEntry[int, string]{Key: 1, Value: "v1"}, entry)
+	entry, found = tree.PopMax()
+	s.True(found)
+	s.Equal(BTreeEntry[int, string]{Key: 5, Value: "v5"}, entry)
+	s.Equal(3, tree.Size())
+	s.Equal([]int{2, 3, 4}, tree.Keys())
+
+	for _, want := range []int{2, 3, 4} {
+		entry, found = tree.PopMin()
+		s.True(found)
+		s.Equal(want, entry.Key)
+	}
+	s.True(tree.IsEmpty())
+	s.Nil(tree.root)
+	_, found = tree.PopMax()
+	s.False(found)
+
+	tree.Insert(7, "v7")
+	entry, found = tree.PopMax()
+	s.True(found)
+	s.Equal(7, entry.Key)
+}
+
+func (s *BTreeTestSuite) TestBTree_PopMin_PopMax_Invariants() {
+	for _, degree := range []int{2, 3, 5} {
+		tree := NewBTree[int, int](degree)
+		const n = 1000
+		for i := 0; i < n; i++ {
+			key := (i * 7919) % n
+			tree.Insert(key, key)
+		}
+
+		low, high := 0, n-1
+		for i := 0; low <= high; i++ {
+			var entry BTreeEntry[int, int]
+			var found bool
+			if i%3 == 2 {
+				entry, found = tree.PopMax()
+				s.Require().True(found)
+				s.Require().Equal(high, entry.Key, "degree %d", degree)
+				high--
+			} else {
+				entry, found = tree.PopMin()
+				s.Require().True(found)
+				s.Require().Equal(low, entry.Key, "degree %d", degree)
+				low++
+			}
+			s.Require().Equal(entry.Key, entry.Value)
+			s.Require().Equal(high-low+1, tree.Size())
+			if i%50 == 0 {
+				s.checkBTreeInvariants(tree)
+				if !tree.IsEmpty() {
+					s.Require().Equal(high-low, tree.Rank(high))
+				}
+			}
+		}
+		s.True(tree.IsEmpty())
+	}
+}
+
+func (s *BTreeTestSuite) TestBTree_Delete_AbsentKeys_ThenPopMin() {
+	for _, degree := range []int{2, 3} {
+		tree := NewBTree[int, int](degree)
+		const n = 200
+		for i := 0; i < n; i++ {
+			tree.Insert(i*2, i*2)
+		}
+
+		// Deleting absent keys still tops up children on the way down and can
+		// merge the root's last two children.
+		for i := 0; i < n; i++ {
+			s.Require().False(tree.Delete(i*2 + 1))
+			s.checkBTreeInvariants(tree)
+		}
+		s.Require().Equal(n, tree.Size())
+
+		for i := 0; i < n; i++ {
+			entry, found := tree.PopMin()
+			s.Require().True(found)
+			s.Require().Equal(i*2, entry.Key, "degree %d", degree)
+		}
+		s.Require().True(tree.IsEmpty())
+		_, found := tree.PopMin()
+		s.Require().False(found)
+	}
+}
+
 // ============================================================================
 // Min/Max Tests
 // ============================================================================
@@ -447,6 +555,87 @@ func (s *BTreeTestSuite) TestBTree_Ceiling_NoCeiling() {
 	s.Equal("", val)
 }
 
+func (s *BTreeTestSuite) TestBTree_FloorEntry_CeilingEntry() {
+	tree := NewBTree[int, string](2)
+
+	entry, found := tree.FloorEntry(5)
+	s.False(found)
+	s.Equal(BTreeEntry[int, string]{}, entry)
+	entry, found = tree.CeilingEntry(5)
+	s.False(found)
+	s.Equal(BTreeEntry[int, string]{}, entry)
+
+	for i := 1; i <= 20; i++ {
+		tree.Insert(i*10, fmt.Sprintf("v%d", i*10))
+	}
+
+	cases := []struct {
+		key               int
+		floor, ceiling    int
+		hasFloor, hasCeil bool
+	}{
+		{key: 5, ceiling: 10, hasCeil: true},
+		{key: 10, floor: 10, ceiling: 10, hasFloor: true, hasCeil: true},
+		{key: 55, floor: 50, ceiling: 60, hasFloor: true, hasCeil: true},
+		{key: 200, floor: 200, ceiling: 200, hasFloor: true, hasCeil: true},
+		{key: 250, floor: 200, hasFloor: true},
+	}
+	for _, c := range cases {
+		entry, found := tree.FloorEntry(c.key)
+		s.Equal(c.hasFloor, found, "floor of %d", c.key)
+		if c.hasFloor {
+			s.Equal(BTreeEntry[int, string]{Key: c.floor, Value: fmt.Sprintf("v%d", c.floor)}, entry)
+		}
+
+		floorKey, floorValue, _ := tree.Floor(c.key)
+		s.Equal(entry.Key, floorKey)
+		s.Equal(entry.Value, floorValue)
+
+		entry, found = tree.CeilingEntry(c.key)
+		s.Equal(c.hasCeil, found, "ceiling of %d", c.key)
+		if c.hasCeil {
+			s.Equal(BTreeEntry[int, string]{Key: c.ceiling, Value: fmt.Sprintf("v%d", c.ceiling)}, entry)
+		}
+
+		ceilingKey, ceilingValue, _ := tree.Ceiling(c.key)
+		s.Equal(entry.Key, ceilingKey)
+		s.Equal(entry.Value, ceilingValue)
+	}
+}
+
+func (s *BTreeTestSuite) TestBTree_FirstN_LastN() {
+	tree := NewBTree[int, string](2)
+	s.Empty(tree.FirstN(3))
+	s.Empty(tree.LastN(3))
+
+	for i := 20; i >= 1; i-- {
+		tree.Insert(i, fmt.Sprintf("v%d", i))
+	}
+
+	keys := func(entries []BTreeEntry[int, string]) []int {
+		out := make([]int, 0, len(entries))
+		for _, e := range entries {
+			out = append(out, e.Key)
+		}
+		return out
+	}
+
+	s.Equal([]int{1, 2, 3}, keys(tree.FirstN(3)))
+	s.Equal([]int{18, 19, 20}, keys(tree.LastN(3)))
+	s.Equal(BTreeEntry[int, string]{Key: 1, Value: "v1"}, tree.FirstN(1)[0])
+	s.Equal(BTreeEntry[int, string]{Key: 20, Value: "v20"}, tree.LastN(1)[0])
+
+	s.NotNil(tree.FirstN(0))
+	s.Empty(tree.FirstN(0))
+	s.Empty(tree.LastN(0))
+	s.Empty(tree.FirstN(-1))
+	s.Empty(tree.LastN(-1))
+
+	s.Len(tree.FirstN(100), 20)
+	s.Equal(keys(tree.FirstN(100)), keys(tree.LastN(100)))
+	s.True(slices.IsSorted(keys(tree.LastN(100))))
+}
+
 // ============================================================================
 // Range Query Tests
 // ============================================================================
@@ -590,6 +779,125 @@ func (s *BTreeTestSuite) TestBTree_All_EarlyBreak() {
 	s.Equal(3, count)
 }
 
+// ============================================================================
+// Reverse Iterator Tests
+// ============================================================================
+
+func (s *BTreeTestSuite) TestBTree_AllReverse_Empty() {
+	tree := NewBTree[int, string](2)
+
+	var results []BTreeEntry[int, string]
+	for entry := range tree.AllReverse() {
+		results = append(results, entry)
+	}
+
+	s.Empty(results)
+}
+
+func (s *BTreeTestSuite) TestBTree_AllReverse_InOrder() {
+	for _, degree := range []int{2, 3, 5} {
+		tree := NewBTree[int, int](degree)
+		expected := make([]int, 0, 200)
+		for i := 0; i < 200; i++ {
+			key := (i * 37) % 200
+			tree.Insert(key, key*10)
+		}
+		for i := 199; i >= 0; i-- {
+			expected = append(expected, i)
+		}
+
+		var keys []int
+		for entry := range tree.AllReverse() {
+			s.Equal(entry.Key*10, entry.Value)
+			keys = append(keys, entry.Key)
+		}
+
+		s.Equal(expected, keys, "degree %d", degree)
+	}
+}
+
+func (s *BTreeTestSuite) TestBTree_AllReverse_EarlyBreak() {
+	tree := NewBTree[int, string](2)
+
+	for i := 1; i <= 10; i++ {
+		tree.Insert(i, "value")
+	}
+
+	var keys []int
+	for entry := range tree.AllReverse() {
+		keys = append(keys, entry.Key)
+		if len(keys) == 3 {
+			break
+		}
+	}
+
+	s.Equal([]int{10, 9, 8}, keys)
+}
+
+func (s *BTreeTestSuite) TestBTree_RangeReverse() {
+	tree := NewBTree[int, string](2)
+
+	for i := 1; i <= 100; i += 2 {
+		tree.Insert(i, "value")
+	}
+
+	cases := []struct {
+		from, to int
+	}{
+		{1, 99},
+		{0, 200},
+		{10, 20},
+		{11, 21},
+		{50, 50},
+		{51, 51},
+		{-10, 0},
+		{100, 200},
+	}
+	for _, c := range cases {
+		var expected []int
+		for entry := range tree.Range(c.from, c.to) {
+			expected = append([]int{entry.Key}, expected...)
+		}
+
+		var keys []int
+		for entry := range tree.RangeReverse(c.from, c.to) {
+			keys = append(keys, entry.Key)
+		}
+
+		s.Equal(expected, keys, "[%d, %d]", c.from, c.to)
+	}
+}
+
+func (s *BTreeTestSuite) TestBTree_RangeReverse_InvalidBounds() {
+	tree := NewBTree[int, string](2)
+	tree.Insert(1, "one")
+
+	var results []BTreeEntry[int, string]
+	for entry := range tree.RangeReverse(10, 1) {
+		results = append(results, entry)
+	}
+
+	s.Empty(results)
+}
+
+func (s *BTreeTestSuite) TestBTree_RangeReverse_Backfill() {
+	// Read the most recent messages of an offset index first
+	tree := NewBTree[uint64, int64](4)
+	for i := uint64(0); i < 1000; i++ {
+		tree.Insert(i, int64(1000000+i))
+	}
+
+	var offsets []uint64
+	for entry := range tree.RangeReverse(100, 900) {
+		offsets = append(offsets, entry.Key)
+		if len(offsets) == 5 {
+			break
+		}
+	}
+
+	s.Equal([]uint64{900, 899, 898, 897, 896}, offsets)
+}
+
 // ============================================================================
 // Keys/Values Tests
 // ============================================================================
@@ -630,6 +938,39 @@ func (s *BTreeTestSuite) TestBTree_Values_InKeyOrder() {
 	s.Equal([]string{"one", "two", "three"}, values)
 }
 
+func (s *BTreeTestSuite) TestBTree_KeysSeq_ValuesSeq() {
+	tree := NewBTree[int, string](2)
+	s.Empty(slices.Collect(tree.KeysSeq()))
+	s.Empty(slices.Collect(tree.ValuesSeq()))
+
+	for i := 100; i >= 1; i-- {
+		tree.Insert(i, fmt.Sprintf("v%d", i))
+	}
+
+	s.Equal(tree.Keys(), slices.Collect(tree.KeysSeq()))
+	s.Equal(tree.Values(), slices.Collect(tree.ValuesSeq()))
+
+	var evens []int
+	for k := range tree.KeysSeq() {
+		if k > 10 {
+			break
+		}
+		if k%2 == 0 {
+			evens = append(evens, k)
+		}
+	}
+	s.Equal([]int{2, 4, 6, 8, 10}, evens)
+
+	var first []string
+	for v := range tree.ValuesSeq() {
+		first = append(first, v)
+		if len(first) == 3 {
+			break
+		}
+	}
+	s.Equal([]string{"v1", "v2", "v3"}, first)
+}
+
 // ============================================================================
 // Height Tests
 // ============================================================================
@@ -659,6 +1000,67 @@ func (s *BTreeTestSuite) TestBTree_Height_AfterSplit() {
 	s.Greater(tree.Height(), 1)
 }
 
+func (s *BTreeTestSuite) TestBTree_LevelEntries() {
+	tree := NewBTree[int, string](2)
+	s.Empty(tree.LevelEntries(0))
+
+	for i := 1; i <= 4; i++ {
+		tree.Insert(i, "value")
+	}
+
+	keys := func(level int) [][]int {
+		var out [][]int
+		for _, entries := range tree.LevelEntries(level) {
+			nodeKeys := make([]int, 0, len(entries))
+			for _, e := range entries {
+				nodeKeys = append(nodeKeys, e.Key)
+			}
+			out = append(out, nodeKeys)
+		}
+		return out
+	}
+
+	s.Equal(2, tree.Height())
+	s.Equal([][]int{{2}}, keys(0))
+	s.Equal([][]int{{1}, {3, 4}}, keys(1))
+	s.NotNil(tree.LevelEntries(2))
+	s.Empty(tree.LevelEntries(2))
+	s.Empty(tree.LevelEntries(-1))
+}
+
+func (s *BTreeTestSuite) TestBTree_LevelEntries_Structure() {
+	tree := NewBTree[int, string](3)
+	for i := 0; i < 500; i++ {
+		tree.Insert((i*7919)%500, "value")
+	}
+	for i := 0; i < 500; i += 3 {
+		tree.Delete(i)
+	}
+
+	total := 0
+	for level := 0; level < tree.Height(); level++ {
+		var levelKeys []int
+		for _, entries := range tree.LevelEntries(level) {
+			if level > 0 {
+				s.GreaterOrEqual(len(entries), tree.MinDegree()-1, "underfull node at level %d", level)
+			}
+			s.LessOrEqual(len(entries), 2*tree.MinDegree()-1, "overfull node at level %d", level)
+			for _, e := range entries {
+				levelKeys = append(levelKeys, e.Key)
+			}
+		}
+		s.True(slices.IsSorted(levelKeys), "level %d keys out of order", level)
+		total += len(levelKeys)
+	}
+	s.Equal(tree.Size(), total)
+
+	// Mutating the returned entries must not affect the tree.
+	tree.LevelEntries(0)[0][0].Value = "changed"
+	for entry := range tree.All() {
+		s.Equal("value", entry.Value)
+	}
+}
+
 // ============================================================================
 // Clear Tests
 // ============================================================================
@@ -779,6 +1181,361 @@ func (s *BTreeTestSuite) TestBTree_HighMinDegree() {
 	s.LessOrEqual(tree.Height(), 4)
 }
 
+// ============================================================================
+// BulkLoad Tests
+// ============================================================================
+
+// checkBTreeInvariants verifies key counts, key ordering and uniform leaf depth.
+func (s *BTreeTestSuite) checkBTreeInvariants(tree *BTree[int, int]) {
+	if tree.root == nil {
+		return
+	}
+
+	s.NotEmpty(tree.root.entries, "a non-nil root holds at least one entry")
+	minDeg := tree.MinDegree()
+	leafDepth := -1
+	type frame struct {
+		node  *btreeNode[int, int]
+		depth int
+	}
+	stack := []frame{{tree.root, 1}}
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		count := len(f.node.entries)
+		for _, child := range f.node.children {
+			count += child.count
+		}
+		s.Equal(count, f.node.count)
+		s.LessOrEqual(len(f.node.entries), 2*minDeg-1)
+		if f.node != tree.root {
+			s.GreaterOrEqual(len(f.node.entries), minDeg-1)
+		}
+		for i := 1; i < len(f.node.entries); i++ {
+			s.Less(f.node.entries[i-1].Key, f.node.entries[i].Key)
+		}
+
+		if f.node.leaf {
+			if leafDepth < 0 {
+				leafDepth = f.depth
+			}
+			s.Equal(leafDepth, f.depth)
+			continue
+		}
+
+		s.Len(f.node.children, len(f.node.entries)+1)
+		for _, child := range f.node.children {
+			stack = append(stack, frame{child, f.depth + 1})
+		}
+	}
+	s.Equal(tree.Height(), leafDepth)
+	s.Equal(tree.Size(), tree.root.count)
+}
+
+func (s *BTreeTestSuite) TestBulkLoad_MatchesIncremental() {
+	for _, degree := range []int{2, 3, 4, 7} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 15, 16, 17, 100, 1000, 4097} {
+			entries := make([]BTreeEntry[int, int], n)
+			incremental := NewBTree[int, int](degree)
+			for i := range entries {
+				entries[i] = BTreeEntry[int, int]{Key: i * 2, Value: i}
+				incremental.Insert(i*2, i)
+			}
+
+			tree, err := BulkLoad(entries, degree)
+			s.Require().NoError(err)
+			s.Equal(n, tree.Size(), "degree %d n %d", degree, n)
+			s.Equal(degree, tree.MinDegree())
+			s.checkBTreeInvariants(tree)
+
+			s.Equal(slices.Collect(incremental.All()), slices.Collect(tree.All()), "degree %d n %d", degree, n)
+			s.Equal(
+				slices.Collect(incremental.Range(n/4, n)),
+				slices.Collect(tree.Range(n/4, n)),
+				"degree %d n %d", degree, n,
+			)
+			for i := 0; i < n; i++ {
+				s.True(tree.Contains(i * 2))
+				s.False(tree.Contains(i*2 + 1))
+			}
+		}
+	}
+}
+
+func (s *BTreeTestSuite) TestBulkLoad_RemainsMutable() {
+	entries := make([]BTreeEntry[int, int], 500)
+	for i := range entries {
+		entries[i] = BTreeEntry[int, int]{Key: i, Value: i}
+	}
+
+	tree, err := BulkLoad(entries, 3)
+	s.Require().NoError(err)
+
+	for i := 0; i < 500; i += 2 {
+		s.True(tree.Delete(i))
+	}
+	for i := 500; i < 600; i++ {
+		tree.Insert(i, i)
+	}
+	s.checkBTreeInvariants(tree)
+	s.Equal(350, tree.Size())
+
+	key, _, found := tree.Min()
+	s.True(found)
+	s.Equal(1, key)
+}
+
+func (s *BTreeTestSuite) TestBulkLoad_NotAscending() {
+	_, err := BulkLoad([]BTreeEntry[int, int]{{Key: 1}, {Key: 3}, {Key: 2}}, 2)
+	s.ErrorIs(err, ErrNotStrictlyAscending)
+
+	_, err = BulkLoad([]BTreeEntry[int, int]{{Key: 1}, {Key: 2}, {Key: 2}}, 2)
+	s.ErrorIs(err, ErrNotStrictlyAscending)
+}
+
+// ============================================================================
+// Rank Tests
+// ============================================================================
+
+func (s *BTreeTestSuite) TestBTree_Rank_Empty() {
+	tree := NewBTree[int, int](2)
+
+	s.Equal(0, tree.Rank(10))
+	s.Equal(0, tree.CountRange(0, 10))
+}
+
+func (s *BTreeTestSuite) TestBTree_Rank() {
+	tree := NewBTree[int, int](2)
+	for i := 1; i <= 50; i++ {
+		tree.Insert(i*10, i)
+	}
+
+	s.Equal(0, tree.Rank(10))
+	s.Equal(0, tree.Rank(5))
+	s.Equal(1, tree.Rank(11))
+	s.Equal(4, tree.Rank(50))
+	s.Equal(49, tree.Rank(500))
+	s.Equal(50, tree.Rank(501))
+
+	s.Equal(50, tree.CountRange(0, 1000))
+	s.Equal(11, tree.CountRange(100, 200))
+	s.Equal(10, tree.CountRange(101, 200))
+	s.Equal(1, tree.CountRange(100, 100))
+	s.Equal(0, tree.CountRange(101, 109))
+	s.Equal(0, tree.CountRange(200, 100))
+}
+
+func (s *BTreeTestSuite) TestBTree_Rank_MatchesIteration() {
+	for _, degree := range []int{2, 3, 5} {
+		tree := NewBTree[int, int](degree)
+		for i := 0; i < 600; i++ {
+			key := (i * 7919) % 1000
+			tree.Insert(key, i)
+		}
+		for i := 0; i < 1000; i += 3 {
+			tree.Delete((i * 31) % 1000)
+		}
+		for i := 0; i < 100; i++ {
+			tree.Insert(1000+i, i)
+		}
+		s.checkBTreeInvariants(tree)
+
+		keys := tree.Keys()
+		for probe := -1; probe <= 1101; probe += 7 {
+			naive := 0
+			for _, key := range keys {
+				if key < probe {
+					naive++
+				}
+			}
+			s.Equal(naive, tree.Rank(probe), "degree %d probe %d", degree, probe)
+
+			count := 0
+			for range tree.Range(probe, probe+50) {
+				count++
+			}
+			s.Equal(count, tree.CountRange(probe, probe+50), "degree %d probe %d", degree, probe)
+		}
+	}
+}
+
+func (s *BTreeTestSuite) TestBTree_Rank_Pagination() {
+	tree := NewBTree[uint64, int64](4)
+	for i := uint64(0); i < 1000; i += 2 {
+		tree.Insert(i, int64(i))
+	}
+
+	s.Equal(50, tree.Rank(100))
+	s.Equal(51, tree.CountRange(100, 200))
+	tree.Clear()
+	s.Equal(0, tree.Rank(100))
+}
+
+func (s *BTreeTestSuite) TestBTree_Select() {
+	tree := NewBTree[int, string](2)
+
+	_, found := tree.Select(0)
+	s.False(found)
+
+	for _, key := range []int{50, 10, 40, 20, 30} {
+		tree.Insert(key, "value")
+	}
+
+	for k, want := range []int{10, 20, 30, 40, 50} {
+		entry, found := tree.Select(k)
+		s.True(found)
+		s.Equal(want, entry.Key)
+	}
+
+	_, found = tree.Select(5)
+	s.False(found)
+	_, found = tree.Select(-1)
+	s.False(found)
+}
+
+func (s *BTreeTestSuite) TestBTree_Select_MatchesIteration() {
+	for _, degree := range []int{2, 3, 5} {
+		tree := NewBTree[int, int](degree)
+		for i := 0; i < 800; i++ {
+			tree.Insert((i*7919)%2000, i)
+		}
+		for i := 0; i < 2000; i += 5 {
+			tree.Delete(i)
+		}
+
+		k := 0
+		for entry := range tree.All() {
+			selected, found := tree.Select(k)
+			s.True(found)
+			s.Equal(entry, selected, "degree %d k %d", degree, k)
+			s.Equal(k, tree.Rank(selected.Key))
+			k++
+		}
+		s.Equal(tree.Size(), k)
+	}
+}
+
+// ============================================================================
+// Clone Tests
+// ============================================================================
+
+func (s *BTreeTestSuite) TestBTree_Clone_Empty() {
+	tree := NewBTree[int, int](3)
+
+	clone := tree.Clone()
+	s.True(clone.IsEmpty())
+	s.Equal(3, clone.MinDegree())
+
+	clone.Insert(1, 1)
+	s.True(tree.IsEmpty())
+}
+
+func (s *BTreeTestSuite) TestBTree_Clone_Independent() {
+	tree := NewBTree[int, int](3)
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i, i)
+	}
+
+	clone := tree.Clone()
+	s.Equal(tree.Size(), clone.Size())
+	s.Equal(tree.MinDegree(), clone.MinDegree())
+	s.Equal(tree.Height(), clone.Height())
+	s.Equal(tree.Keys(), clone.Keys())
+
+	for i := 0; i < 1000; i += 2 {
+		s.True(clone.Delete(i))
+	}
+	clone.Insert(5000, 5000)
+	clone.Insert(1, -1)
+	s.checkBTreeInvariants(clone)
+	s.Equal(501, clone.Size())
+
+	s.Equal(1000, tree.Size())
+	for i := 0; i < 1000; i++ {
+		s.True(tree.Contains(i))
+		val, _ := tree.Search(i)
+		s.Equal(i, val)
+	}
+	s.False(tree.Contains(5000))
+	s.checkBTreeInvariants(tree)
+}
+
+// ============================================================================
+// Upsert Tests
+// ============================================================================
+
+func (s *BTreeTestSuite) TestBTree_Upsert_Counters() {
+	for _, degree := range []int{2, 3, 4} {
+		tree := NewBTree[int, int](degree)
+		expected := make(map[int]int)
+
+		for i := 0; i < 3000; i++ {
+			key := (i * 7919) % 400
+			calls := 0
+			tree.Upsert(key, func(old int, existed bool) int {
+				calls++
+				_, want := expected[key]
+				s.Equal(want, existed)
+				s.Equal(expected[key], old)
+				return old + 1
+			})
+			s.Equal(1, calls)
+			expected[key]++
+		}
+
+		s.Equal(len(expected), tree.Size(), "degree %d", degree)
+		s.checkBTreeInvariants(tree)
+		for key, count := range expected {
+			val, found := tree.Search(key)
+			s.True(found)
+			s.Equal(count, val)
+		}
+	}
+}
+
+func (s *BTreeTestSuite) TestBTree_Upsert_InsertsIntoEmpty() {
+	tree := NewBTree[string, int](2)
+
+	tree.Upsert("a", func(old int, existed bool) int {
+		s.False(existed)
+		s.Zero(old)
+		return 42
+	})
+
+	val, found := tree.Search("a")
+	s.True(found)
+	s.Equal(42, val)
+	s.Equal(1, tree.Size())
+}
+
+func (s *BTreeTestSuite) TestBTree_Upsert_UpdatesPromotedMedian() {
+	tree := NewBTree[int, string](2)
+	for i := 1; i <= 5; i++ {
+		tree.Insert(i, "old")
+	}
+
+	// root [2] has a full right child [3 4 5]; the walk splits it and promotes 4
+	s.Equal([]BTreeEntry[int, string]{{Key: 3, Value: "old"}, {Key: 4, Value: "old"}, {Key: 5, Value: "old"}},
+		tree.root.children[1].entries)
+	tree.Upsert(4, func(old string, existed bool) string {
+		s.True(existed)
+		s.Equal("old", old)
+		return "new"
+	})
+
+	val, _ := tree.Search(4)
+	s.Equal("new", val)
+	s.Equal(5, tree.Size())
+	s.Equal(4, tree.root.entries[1].Key)
+}
+
+func (s *BTreeTestSuite) TestBTree_Upsert_NilFunc() {
+	tree := NewBTree[int, int](2)
+	tree.Upsert(1, nil)
+	s.True(tree.IsEmpty())
+}
+
 // ============================================================================
 // Message Queue Specific Tests (Use Case)
 // ============================================================================
@@ -0,0 +1,68 @@
+package tree
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// subtreeShape identifies a subtree by its value and the sorted shapes of its children.
+type subtreeShape[T comparable] struct {
+	val      T
+	children string
+}
+
+// shapeOf returns the canonical shape code of the subtree rooted at root. Codes
+// are interned in shapes, so two subtrees share a code exactly when they have
+// equal values and the same multiset of child shapes.
+func shapeOf[T comparable](root *Node[T], shapes map[subtreeShape[T]]int) int {
+	order := slices.Collect(root.DescendantsIter())
+	codes := make(map[*Node[T]]int, len(order))
+
+	// pre-order reversed visits every child before its parent
+	for i := len(order) - 1; i >= 0; i-- {
+		current := order[i]
+		childCodes := make([]int, 0, len(current.children))
+		for _, child := range current.children {
+			childCodes = append(childCodes, codes[child])
+		}
+		slices.Sort(childCodes)
+
+		encoded := make([]byte, 0, len(childCodes)*binary.MaxVarintLen64)
+		for _, code := range childCodes {
+			encoded = binary.AppendUvarint(encoded, uint64(code))
+		}
+
+		shape := subtreeShape[T]{val: current.val, children: string(encoded)}
+		code, known := shapes[shape]
+		if !known {
+			code = len(shapes)
+			shapes[shape] = code
+		}
+		codes[current] = code
+	}
+
+	return codes[root]
+}
+
+// Equal reports whether the subtrees rooted at a and b have the same shape and
+// the same values at corresponding positions. Node IDs, levels and max breadths
+// are ignored.
+//
+// Children are compared as an unordered multiset: two nodes are equal when their
+// values match and their children can be paired one-to-one into equal subtrees,
+// regardless of attach order. Two nil nodes are equal; a nil and a non-nil node
+// are not.
+//
+// Time complexity: O(n log n) where n is the total number of nodes
+func Equal[T comparable](a, b *Node[T]) bool {
+	switch {
+	case a == nil || b == nil:
+		return a == b
+	case a == b:
+		return true
+	}
+
+	shapes := make(map[subtreeShape[T]]int)
+
+	return shapeOf(a, shapes) == shapeOf(b, shapes)
+}
@@ -19,4 +19,5 @@ var (
 	ErrParentNotInSegment     = errors.New("parent node not in segment")
 	ErrCannotRemoveRoot       = errors.New("cannot remove root with children using promote strategy")
 	ErrNodesNotInSegment      = errors.New("one or both nodes not in segment")
+	ErrNotStrictlyAscending   = errors.New("entries are not strictly ascending")
 )
@@ -4,6 +4,11 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
+// Number is the set of numeric types a Fenwick tree can aggregate.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
 // Fenwick is a data structure that efficiently
 // supports prefix sum queries and point updates in O(log n) time.
 //
@@ -77,6 +82,9 @@ func (t *Fenwick[T]) Size() int {
 //
 //	ft.Update(3, 5)  // Add 5 to index 3
 //	ft.Update(3, -2) // Subtract 2 from index 3
+//
+// For unsigned types, subtract by passing the wrapped delta, e.g.
+// Update(3, ^uint(0)-1) subtracts 2, or use Set.
 func (t *Fenwick[T]) Update(index int, delta T) {
 	if index <= 0 || index > t.n {
 		return // Out of bounds, silently ignore
@@ -133,8 +141,46 @@ func (t *Fenwick[T]) RangeQuery(left, right int) T {
 	return t.Query(right) - t.Query(left-1)
 }
 
+// LowerBound returns the smallest 1-based index i such that Query(i) >= target,
+// or Size()+1 when the total sum is below target.
+// It walks the implicit tree top-down with binary lifting instead of
+// binary-searching over Query, which makes it useful for weighted random
+// selection over a frequency table.
+//
+// Precondition: all stored values are non-negative, so prefix sums are
+// non-decreasing. The result is unspecified otherwise.
+//
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	ft := FromSlice([]int{1, 0, 2, 3})
+//	idx := ft.LowerBound(3) // returns 3 (prefix sums: 1, 1, 3, 6)
+func (t *Fenwick[T]) LowerBound(target T) int {
+	step := 1
+	for step<<1 <= t.n {
+		step <<= 1
+	}
+
+	var sum T
+	pos := 0
+	for ; step > 0; step >>= 1 {
+		next := pos + step
+		if next <= t.n && sum+t.tree[next] < target {
+			pos = next
+			sum += t.tree[next]
+		}
+	}
+
+	return pos + 1
+}
+
 // Set sets the element at the given 1-based index to the specified value.
 // This is implemented as: Update(index, newValue - currentValue)
+//
+// For unsigned types, lowering a value makes the difference wrap around.
+// Unsigned arithmetic is modular, so the wrapped delta cancels exactly when it
+// is added back and all results remain correct as long as the true sums fit in T.
 // Time complexity: O(log n)
 //
 // Example:
@@ -0,0 +1,110 @@
+package tree
+
+// Fenwick2D is a two-dimensional Fenwick tree supporting point updates and
+// rectangular prefix sum queries over a rows x cols grid in O(log rows * log cols).
+//
+// Like the 1D Fenwick, it uses 1-based indexing on both axes and silently
+// ignores updates outside the grid.
+//
+// Common use cases:
+//   - Heatmaps and density grids
+//   - Counting points inside axis-aligned rectangles
+//   - Aggregations over a matrix that changes incrementally
+type Fenwick2D[T Number] struct {
+	tree [][]T
+	rows int
+	cols int
+}
+
+// NewFenwick2D creates a new Fenwick2D with the given dimensions.
+// The grid is initialized with all zeros. Negative dimensions are treated as zero.
+//
+// Example:
+//
+//	grid := NewFenwick2D[int](3, 4)
+func NewFenwick2D[T Number](rows, cols int) *Fenwick2D[T] {
+	rows = max(rows, 0)
+	cols = max(cols, 0)
+
+	tree := make([][]T, rows+1) // row 0 is unused, rows 1..rows are used
+	for r := range tree {
+		tree[r] = make([]T, cols+1)
+	}
+
+	return &Fenwick2D[T]{
+		tree: tree,
+		rows: rows,
+		cols: cols,
+	}
+}
+
+// Rows returns the number of rows in the grid.
+// Time complexity: O(1)
+func (t *Fenwick2D[T]) Rows() int {
+	return t.rows
+}
+
+// Cols returns the number of columns in the grid.
+// Time complexity: O(1)
+func (t *Fenwick2D[T]) Cols() int {
+	return t.cols
+}
+
+// Update adds delta to the cell at the given 1-based (row, col).
+// Time complexity: O(log rows * log cols)
+//
+// Example:
+//
+//	grid.Update(2, 3, 5) // Add 5 to cell (2, 3)
+func (t *Fenwick2D[T]) Update(row, col int, delta T) {
+	if row <= 0 || row > t.rows || col <= 0 || col > t.cols {
+		return // Out of bounds, silently ignore
+	}
+
+	for r := row; r <= t.rows; r += r & -r {
+		for c := col; c <= t.cols; c += c & -c {
+			t.tree[r][c] += delta
+		}
+	}
+}
+
+// Query returns the sum of the rectangle spanning (1, 1) to (row, col) inclusive.
+// Coordinates beyond the grid are clamped to its last row or column.
+// Time complexity: O(log rows * log cols)
+//
+// Example:
+//
+//	sum := grid.Query(2, 3) // Sum of rows 1..2, columns 1..3
+func (t *Fenwick2D[T]) Query(row, col int) T {
+	var sum T
+	if row <= 0 || col <= 0 {
+		return sum
+	}
+
+	row = min(row, t.rows)
+	col = min(col, t.cols)
+
+	for r := row; r > 0; r -= r & -r {
+		for c := col; c > 0; c -= c & -c {
+			sum += t.tree[r][c]
+		}
+	}
+
+	return sum
+}
+
+// RangeQuery returns the sum of the rectangle with corners (row1, col1) and
+// (row2, col2), 1-based and inclusive. Invalid or out-of-bounds rectangles yield zero.
+// Time complexity: O(log rows * log cols)
+//
+// Example:
+//
+//	sum := grid.RangeQuery(2, 2, 3, 4) // Sum of rows 2..3, columns 2..4
+func (t *Fenwick2D[T]) RangeQuery(row1, col1, row2, col2 int) T {
+	if row1 > row2 || col1 > col2 || row1 <= 0 || col1 <= 0 || row2 > t.rows || col2 > t.cols {
+		var zero T
+		return zero
+	}
+
+	return t.Query(row2, col2) - t.Query(row1-1, col2) - t.Query(row2, col1-1) + t.Query(row1-1, col1-1)
+}
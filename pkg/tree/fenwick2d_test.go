@@ -0,0 +1,123 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// Fenwick2DTestSuite tests the two-dimensional Fenwick tree
+type Fenwick2DTestSuite struct {
+	suite.Suite
+}
+
+// fill loads grid (0-indexed rows of values) into a new Fenwick2D.
+func (s *Fenwick2DTestSuite) fill(grid [][]int) *Fenwick2D[int] {
+	ft := NewFenwick2D[int](len(grid), len(grid[0]))
+	for r, row := range grid {
+		for c, v := range row {
+			ft.Update(r+1, c+1, v)
+		}
+	}
+	return ft
+}
+
+// bruteSum sums grid over the 1-based inclusive rectangle.
+func bruteSum(grid [][]int, row1, col1, row2, col2 int) int {
+	sum := 0
+	for r := row1; r <= row2; r++ {
+		for c := col1; c <= col2; c++ {
+			sum += grid[r-1][c-1]
+		}
+	}
+	return sum
+}
+
+func (s *Fenwick2DTestSuite) TestNew() {
+	ft := NewFenwick2D[int](3, 4)
+	s.Require().Equal(3, ft.Rows())
+	s.Require().Equal(4, ft.Cols())
+	s.Require().Equal(0, ft.Query(3, 4))
+
+	empty := NewFenwick2D[float64](-1, 5)
+	s.Require().Equal(0, empty.Rows())
+	s.Require().Equal(5, empty.Cols())
+	s.Require().Zero(empty.Query(1, 1))
+}
+
+func (s *Fenwick2DTestSuite) TestQueryMatchesBruteForce() {
+	grid := [][]int{
+		{3, 0, 1, 4, 2},
+		{5, 6, 3, 2, 1},
+		{1, 2, 0, 1, 5},
+		{4, 1, 0, 1, 7},
+	}
+	ft := s.fill(grid)
+
+	for r := 1; r <= 4; r++ {
+		for c := 1; c <= 5; c++ {
+			s.Require().Equal(bruteSum(grid, 1, 1, r, c), ft.Query(r, c), "prefix (%d,%d)", r, c)
+		}
+	}
+
+	for r1 := 1; r1 <= 4; r1++ {
+		for c1 := 1; c1 <= 5; c1++ {
+			for r2 := r1; r2 <= 4; r2++ {
+				for c2 := c1; c2 <= 5; c2++ {
+					s.Require().Equal(bruteSum(grid, r1, c1, r2, c2), ft.RangeQuery(r1, c1, r2, c2))
+				}
+			}
+		}
+	}
+}
+
+func (s *Fenwick2DTestSuite) TestUpdateAccumulates() {
+	ft := NewFenwick2D[int](3, 3)
+
+	ft.Update(2, 2, 10)
+	ft.Update(2, 2, -4)
+	ft.Update(3, 1, 7)
+
+	s.Require().Equal(6, ft.RangeQuery(2, 2, 2, 2))
+	s.Require().Equal(7, ft.RangeQuery(3, 1, 3, 1))
+	s.Require().Equal(13, ft.Query(3, 3))
+	s.Require().Equal(0, ft.Query(1, 3))
+}
+
+func (s *Fenwick2DTestSuite) TestOutOfBounds() {
+	ft := NewFenwick2D[int](2, 3)
+	ft.Update(1, 1, 1)
+	ft.Update(2, 3, 2)
+
+	// These should be silently ignored
+	ft.Update(0, 1, 100)
+	ft.Update(1, 0, 100)
+	ft.Update(3, 1, 100)
+	ft.Update(1, 4, 100)
+	s.Require().Equal(3, ft.Query(2, 3))
+
+	// Query clamps to the grid
+	s.Require().Equal(3, ft.Query(10, 10))
+	s.Require().Equal(1, ft.Query(1, 10))
+	s.Require().Equal(0, ft.Query(0, 3))
+	s.Require().Equal(0, ft.Query(2, -1))
+
+	// Invalid rectangles yield zero
+	s.Require().Equal(0, ft.RangeQuery(2, 1, 1, 3))
+	s.Require().Equal(0, ft.RangeQuery(1, 3, 2, 2))
+	s.Require().Equal(0, ft.RangeQuery(0, 1, 2, 3))
+	s.Require().Equal(0, ft.RangeQuery(1, 1, 3, 3))
+}
+
+func (s *Fenwick2DTestSuite) TestFloat64() {
+	ft := NewFenwick2D[float64](2, 2)
+	ft.Update(1, 1, 1.5)
+	ft.Update(2, 2, 2.25)
+
+	s.Require().InDelta(3.75, ft.Query(2, 2), 0.001)
+	s.Require().InDelta(2.25, ft.RangeQuery(2, 2, 2, 2), 0.001)
+}
+
+func TestFenwick2DTestSuite(t *testing.T) {
+	suite.Run(t, new(Fenwick2DTestSuite))
+}
@@ -0,0 +1,103 @@
+package tree
+
+import (
+	"cmp"
+)
+
+// FenwickMax is a Fenwick tree variant that maintains prefix maxima instead
+// of prefix sums, answering "largest value in [1, i]" in O(log n) time.
+//
+// Maximum has no inverse, so the structure is monotone: Update can only
+// raise the value stored at an index, never lower it, and there is no Set or
+// RangeQuery over arbitrary [left, right]. To lower values, Clear the tree
+// and replay the updates.
+type FenwickMax[T cmp.Ordered] struct {
+	tree   []T
+	filled []bool // filled[i] reports whether tree[i] holds a real value
+	n      int
+}
+
+// NewFenwickMax creates a new FenwickMax with the given size.
+// All elements start out empty.
+//
+// Example:
+//
+//	fm := NewFenwickMax[int](10)
+func NewFenwickMax[T cmp.Ordered](size int) *FenwickMax[T] {
+	if size < 0 {
+		size = 0
+	}
+	return &FenwickMax[T]{
+		tree:   make([]T, size+1), // index 0 is unused, indices 1..n are used
+		filled: make([]bool, size+1),
+		n:      size,
+	}
+}
+
+// Size returns the size of the FenwickMax.
+// Time complexity: O(1)
+func (t *FenwickMax[T]) Size() int {
+	return t.n
+}
+
+// Update records value at the given 1-based index, keeping the larger of
+// value and whatever was recorded there before. Values lower than the current
+// maximum have no effect. Out-of-bounds indexes are silently ignored.
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	fm.Update(3, 42)
+//	fm.Update(3, 10) // no effect, 42 is kept
+func (t *FenwickMax[T]) Update(index int, value T) {
+	if index <= 0 || index > t.n {
+		return // Out of bounds, silently ignore
+	}
+
+	for index <= t.n {
+		if !t.filled[index] || value > t.tree[index] {
+			t.tree[index] = value
+			t.filled[index] = true
+		}
+		index += index & -index
+	}
+}
+
+// Query returns the maximum over indices 1 to the given 1-based index (inclusive).
+// Indexes beyond the tree are clamped to Size(). The zero value of T is
+// returned when no index in the prefix has been updated.
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	best := fm.Query(5) // Largest value recorded at indices 1..5
+func (t *FenwickMax[T]) Query(index int) T {
+	var best T
+	if index <= 0 {
+		return best
+	}
+	if index > t.n {
+		index = t.n
+	}
+
+	found := false
+	for index > 0 {
+		if t.filled[index] && (!found || t.tree[index] > best) {
+			best = t.tree[index]
+			found = true
+		}
+		index -= index & -index
+	}
+
+	return best
+}
+
+// Clear resets all elements to empty. This is the only way to lower a value.
+// Time complexity: O(n)
+func (t *FenwickMax[T]) Clear() {
+	var zero T
+	for i := range t.tree {
+		t.tree[i] = zero
+		t.filled[i] = false
+	}
+}
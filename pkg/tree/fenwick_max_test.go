@@ -0,0 +1,87 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// FenwickMaxTestSuite tests prefix-maximum operations
+type FenwickMaxTestSuite struct {
+	suite.Suite
+}
+
+func (s *FenwickMaxTestSuite) TestNew() {
+	fm := NewFenwickMax[int](5)
+	s.Require().Equal(5, fm.Size())
+	s.Require().Equal(0, fm.Query(5))
+
+	s.Require().Equal(0, NewFenwickMax[int](-1).Size())
+}
+
+func (s *FenwickMaxTestSuite) TestQueryMatchesBruteForce() {
+	data := []int{4, 1, 7, 3, 3, 9, 2, 8, 5, 10, 6}
+	fm := NewFenwickMax[int](len(data))
+	for i, v := range data {
+		fm.Update(i+1, v)
+	}
+
+	best := data[0]
+	for i := 1; i <= len(data); i++ {
+		best = max(best, data[i-1])
+		s.Require().Equal(best, fm.Query(i), "prefix %d", i)
+	}
+}
+
+func (s *FenwickMaxTestSuite) TestUpdateIsMonotone() {
+	fm := NewFenwickMax[int](4)
+
+	fm.Update(2, 10)
+	fm.Update(2, 3)
+	s.Require().Equal(10, fm.Query(2))
+
+	fm.Update(2, 12)
+	s.Require().Equal(12, fm.Query(4))
+}
+
+func (s *FenwickMaxTestSuite) TestNegativeValues() {
+	fm := NewFenwickMax[int](4)
+	fm.Update(3, -5)
+	fm.Update(4, -8)
+
+	s.Require().Equal(0, fm.Query(2)) // nothing recorded yet
+	s.Require().Equal(-5, fm.Query(3))
+	s.Require().Equal(-5, fm.Query(4))
+}
+
+func (s *FenwickMaxTestSuite) TestOutOfBounds() {
+	fm := NewFenwickMax[int](3)
+	fm.Update(1, 5)
+
+	// These should be silently ignored
+	fm.Update(0, 100)
+	fm.Update(4, 100)
+
+	s.Require().Equal(5, fm.Query(3))
+	s.Require().Equal(5, fm.Query(100))
+	s.Require().Equal(0, fm.Query(0))
+	s.Require().Equal(0, fm.Query(-1))
+}
+
+func (s *FenwickMaxTestSuite) TestClear() {
+	fm := NewFenwickMax[string](3)
+	fm.Update(1, "m")
+	fm.Update(3, "z")
+	s.Require().Equal("z", fm.Query(3))
+
+	fm.Clear()
+	s.Require().Equal("", fm.Query(3))
+
+	fm.Update(2, "b")
+	s.Require().Equal("", fm.Query(1))
+	s.Require().Equal("b", fm.Query(3))
+}
+
+func TestFenwickMaxTestSuite(t *testing.T) {
+	suite.Run(t, new(FenwickMaxTestSuite))
+}
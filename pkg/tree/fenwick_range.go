@@ -0,0 +1,64 @@
+package tree
+
+// RangeFenwick is the dual of Fenwick: it supports adding a constant to a
+// whole range and reading back a single element, both in O(log n) time.
+//
+// Internally it stores a Fenwick over the difference array, so the value at
+// index i is the prefix sum of differences up to i. Because the internal
+// array has different semantics than Fenwick's, range sums are not offered.
+type RangeFenwick[T Number] struct {
+	diff *Fenwick[T]
+}
+
+// NewRangeFenwick creates a new RangeFenwick with the given size.
+// All elements start at zero.
+//
+// Example:
+//
+//	rf := NewRangeFenwick[int](10)
+func NewRangeFenwick[T Number](size int) *RangeFenwick[T] {
+	return &RangeFenwick[T]{diff: NewFenwick[T](size)}
+}
+
+// Size returns the number of elements.
+// Time complexity: O(1)
+func (t *RangeFenwick[T]) Size() int {
+	return t.diff.Size()
+}
+
+// RangeUpdate adds delta to every element in [left, right] (1-based, inclusive).
+// Invalid or out-of-bounds ranges are silently ignored.
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	rf.RangeUpdate(3, 7, 2) // Add 2 to indices 3 through 7
+func (t *RangeFenwick[T]) RangeUpdate(left, right int, delta T) {
+	if left > right || left <= 0 || right > t.diff.Size() {
+		return
+	}
+
+	t.diff.Update(left, delta)
+	t.diff.Update(right+1, -delta) // no-op when right is the last index
+}
+
+// Get returns the effective value at the given 1-based index.
+// Time complexity: O(log n)
+//
+// Example:
+//
+//	val := rf.Get(5)
+func (t *RangeFenwick[T]) Get(index int) T {
+	if index <= 0 || index > t.diff.Size() {
+		var zero T
+		return zero
+	}
+
+	return t.diff.Query(index)
+}
+
+// Clear resets all elements to zero.
+// Time complexity: O(n)
+func (t *RangeFenwick[T]) Clear() {
+	t.diff.Clear()
+}
@@ -0,0 +1,80 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// RangeFenwickTestSuite tests range-update / point-query operations
+type RangeFenwickTestSuite struct {
+	suite.Suite
+}
+
+func (s *RangeFenwickTestSuite) TestNew() {
+	rf := NewRangeFenwick[int](5)
+	s.Require().Equal(5, rf.Size())
+	for i := 1; i <= 5; i++ {
+		s.Require().Equal(0, rf.Get(i))
+	}
+
+	s.Require().Equal(0, NewRangeFenwick[int](-3).Size())
+}
+
+func (s *RangeFenwickTestSuite) TestRangeUpdateMatchesBruteForce() {
+	const n = 10
+	rf := NewRangeFenwick[int](n)
+	expected := make([]int, n+1)
+
+	updates := []struct {
+		left, right, delta int
+	}{
+		{1, 10, 1},
+		{3, 7, 5},
+		{5, 5, -2},
+		{8, 10, 4},
+		{1, 1, 9},
+		{2, 9, -3},
+	}
+
+	for _, u := range updates {
+		rf.RangeUpdate(u.left, u.right, u.delta)
+		for i := u.left; i <= u.right; i++ {
+			expected[i] += u.delta
+		}
+
+		for i := 1; i <= n; i++ {
+			s.Require().Equal(expected[i], rf.Get(i), "index %d", i)
+		}
+	}
+}
+
+func (s *RangeFenwickTestSuite) TestInvalidRangesIgnored() {
+	rf := NewRangeFenwick[int](5)
+
+	rf.RangeUpdate(0, 3, 10)
+	rf.RangeUpdate(4, 2, 10)
+	rf.RangeUpdate(2, 6, 10)
+	rf.RangeUpdate(-1, -1, 10)
+
+	for i := 1; i <= 5; i++ {
+		s.Require().Equal(0, rf.Get(i))
+	}
+	s.Require().Equal(0, rf.Get(0))
+	s.Require().Equal(0, rf.Get(6))
+}
+
+func (s *RangeFenwickTestSuite) TestClear() {
+	rf := NewRangeFenwick[float64](4)
+	rf.RangeUpdate(1, 4, 1.5)
+	s.Require().InDelta(1.5, rf.Get(3), 0.001)
+
+	rf.Clear()
+	for i := 1; i <= 4; i++ {
+		s.Require().Zero(rf.Get(i))
+	}
+}
+
+func TestRangeFenwickTestSuite(t *testing.T) {
+	suite.Run(t, new(RangeFenwickTestSuite))
+}
@@ -356,6 +356,27 @@ func (s *SetAndGetTestSuite) TestSet_OutOfBounds() {
 	}
 }
 
+func (s *SetAndGetTestSuite) TestSet_UnsignedLowerValue() {
+	ft := FromSlice([]uint{5, 10, 15, 20})
+
+	// Lowering a value makes the internal delta wrap around.
+	ft.Set(2, 3)
+	ft.Set(4, 0)
+
+	s.Require().Equal(uint(3), ft.Get(2))
+	s.Require().Equal(uint(0), ft.Get(4))
+	s.Require().Equal([]uint{5, 3, 15, 0}, ft.ToSlice())
+	s.Require().Equal(uint(8), ft.Query(2))
+	s.Require().Equal(uint(23), ft.Query(4))
+	s.Require().Equal(uint(18), ft.RangeQuery(2, 3))
+	s.Require().Equal(3, ft.LowerBound(9))
+
+	// Subtracting through Update with a wrapped delta behaves the same.
+	ft.Update(3, ^uint(0)-1)
+	s.Require().Equal(uint(13), ft.Get(3))
+	s.Require().Equal(uint(21), ft.Query(4))
+}
+
 func (s *SetAndGetTestSuite) TestGet_AfterMultipleUpdates() {
 	ft := NewFenwick[int](3)
 
@@ -647,6 +668,66 @@ func (s *TypesHeapTestSuite) TestUint() {
 }
 
 // Test suite runners
+// LowerBoundTestSuite tests LowerBound searches
+type LowerBoundTestSuite struct {
+	suite.Suite
+}
+
+func (s *LowerBoundTestSuite) TestLowerBound_MatchesLinearScan() {
+	data := []int{3, 0, 2, 0, 0, 5, 1, 4, 0, 2, 6}
+	ft := FromSlice(data)
+	total := ft.Query(ft.Size())
+
+	for target := 0; target <= total+1; target++ {
+		expected := ft.Size() + 1
+		for i := 1; i <= ft.Size(); i++ {
+			if ft.Query(i) >= target {
+				expected = i
+				break
+			}
+		}
+		s.Require().Equal(expected, ft.LowerBound(target), "target %d", target)
+	}
+}
+
+func (s *LowerBoundTestSuite) TestLowerBound_Examples() {
+	ft := FromSlice([]int{1, 0, 2, 3})
+
+	s.Require().Equal(1, ft.LowerBound(1))
+	s.Require().Equal(3, ft.LowerBound(2))
+	s.Require().Equal(3, ft.LowerBound(3))
+	s.Require().Equal(4, ft.LowerBound(4))
+	s.Require().Equal(4, ft.LowerBound(6))
+	s.Require().Equal(5, ft.LowerBound(7))
+}
+
+func (s *LowerBoundTestSuite) TestLowerBound_AfterUpdates() {
+	ft := NewFenwick[uint](8)
+	ft.Update(5, 4)
+	s.Require().Equal(5, ft.LowerBound(1))
+
+	ft.Update(2, 1)
+	s.Require().Equal(2, ft.LowerBound(1))
+	s.Require().Equal(5, ft.LowerBound(2))
+	s.Require().Equal(9, ft.LowerBound(6))
+}
+
+func (s *LowerBoundTestSuite) TestLowerBound_Float64() {
+	ft := FromSlice([]float64{0.25, 0.25, 0.5})
+
+	s.Require().Equal(1, ft.LowerBound(0.1))
+	s.Require().Equal(2, ft.LowerBound(0.5))
+	s.Require().Equal(3, ft.LowerBound(0.75))
+	s.Require().Equal(4, ft.LowerBound(1.5))
+}
+
+func (s *LowerBoundTestSuite) TestLowerBound_EmptyTree() {
+	ft := NewFenwick[int](0)
+
+	s.Require().Equal(1, ft.LowerBound(0))
+	s.Require().Equal(1, ft.LowerBound(5))
+}
+
 func TestConstructorTestSuite(t *testing.T) {
 	suite.Run(t, new(ConstructorTestSuite))
 }
@@ -682,3 +763,7 @@ func TestComplexOperationsTestSuite(t *testing.T) {
 func TestTypesTestSuite(t *testing.T) {
 	suite.Run(t, new(TypesHeapTestSuite))
 }
+
+func TestLowerBoundTestSuite(t *testing.T) {
+	suite.Run(t, new(LowerBoundTestSuite))
+}
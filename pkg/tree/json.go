@@ -0,0 +1,112 @@
+package tree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// nodeJSON is the wire representation of a Node and its subtree.
+type nodeJSON[T comparable] struct {
+	ID         uint64         `json:"id"`
+	Value      T              `json:"value"`
+	MaxBreadth int            `json:"max_breadth"`
+	Level      int            `json:"level"`
+	Children   []*nodeJSON[T] `json:"children,omitempty"`
+}
+
+func newNodeJSON[T comparable](n *Node[T]) *nodeJSON[T] {
+	return &nodeJSON[T]{
+		ID:         n.id,
+		Value:      n.val,
+		MaxBreadth: n.maxBreadth,
+		Level:      n.level,
+	}
+}
+
+// MarshalJSON serializes the subtree rooted at n: the ID, value, max breadth and
+// level of every node together with its children, ordered by ID.
+func (n *Node[T]) MarshalJSON() ([]byte, error) {
+	type frame struct {
+		src *Node[T]
+		dst *nodeJSON[T]
+	}
+
+	top := newNodeJSON(n)
+	stack := []frame{{src: n, dst: top}}
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, child := range f.src.sortedChildren() {
+			c := newNodeJSON(child)
+			f.dst.Children = append(f.dst.Children, c)
+			stack = append(stack, frame{src: child, dst: c})
+		}
+	}
+
+	return json.Marshal(top)
+}
+
+// UnmarshalJSON replaces n with the subtree described by data, rebuilding every
+// parent/child link. The level of the top node is restored as encoded (level 0
+// makes it a root, any other level leaves it detached from a parent) and the
+// levels of its descendants are derived from it.
+//
+// Returns an error if:
+//   - data is not a valid encoding (json error)
+//   - Two children of the same node share an ID (ErrHierarchyModel)
+//   - A node has more children than its max breadth (ErrMaxBreadth)
+//
+// On error n is left unchanged.
+func (n *Node[T]) UnmarshalJSON(data []byte) error {
+	var top nodeJSON[T]
+	if err := json.Unmarshal(data, &top); err != nil {
+		return err
+	}
+
+	loaded, err := NewNode[T](top.ID, top.MaxBreadth, ValueOpt(top.Value), LevelOpt[T](top.Level))
+	if err != nil {
+		return err
+	}
+	if top.Level == 0 {
+		loaded.state = root
+	}
+
+	type frame struct {
+		src *nodeJSON[T]
+		dst *Node[T]
+	}
+
+	queue := []frame{{src: &top, dst: loaded}}
+	for len(queue) > 0 {
+		f := queue[0]
+		queue = queue[1:]
+
+		for _, c := range f.src.Children {
+			if c == nil {
+				return fmt.Errorf("child of node [%d]: %w", f.src.ID, ErrNil)
+			}
+
+			child, errChild := NewNode[T](c.ID, c.MaxBreadth, ValueOpt(c.Value))
+			if errChild != nil {
+				return errChild
+			}
+			if f.dst.HasChild(child) {
+				return errors.Join(ErrHierarchyModel, fmt.Errorf("duplicate child id [%d] under node [%d]", c.ID, f.src.ID))
+			}
+			if errAttach := f.dst.AttachChild(child); errAttach != nil {
+				return fmt.Errorf("node [%d]: %w", f.dst.id, errAttach)
+			}
+
+			queue = append(queue, frame{src: c, dst: child})
+		}
+	}
+
+	*n = *loaded
+	for _, child := range n.children {
+		child.parent = n
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package tree
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NodeJSONTestSuite struct {
+	suite.Suite
+}
+
+func TestNodeJSONTestSuite(t *testing.T) {
+	suite.Run(t, new(NodeJSONTestSuite))
+}
+
+func (s *NodeJSONTestSuite) buildTree() (*Node[string], map[string]*Node[string]) {
+	model := HierarchyModel{
+		RootTag: {"CEO"},
+		"CEO":   {"CTO", "CFO"},
+		"CTO":   {"PSA", "PSE", "DM"},
+		"CFO":   {"SEM", "PA"},
+	}
+	root, err := Hierarchy(model, 3, nextID)
+	s.Require().NoError(err)
+
+	byValue := make(map[string]*Node[string])
+	stack := []*Node[string]{root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		byValue[n.Val()] = n
+		for _, child := range n.ChildrenIter() {
+			stack = append(stack, child)
+		}
+	}
+	return root, byValue
+}
+
+func (s *NodeJSONTestSuite) TestRoundTrip() {
+	root, original := s.buildTree()
+
+	data, err := json.Marshal(root)
+	s.Require().NoError(err)
+
+	var loaded Node[string]
+	s.Require().NoError(json.Unmarshal(data, &loaded))
+	s.Require().True(loaded.IsRoot())
+	s.Require().False(loaded.HasParent())
+
+	byID := map[uint64]*Node[string]{loaded.ID(): &loaded}
+	stack := []*Node[string]{&loaded}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for id, child := range n.ChildrenIter() {
+			s.Require().True(child.IsChildOf(n))
+			s.Require().True(n.HasChild(child))
+			s.Require().True(child.IsAttached())
+			_, err := n.SelectChildByID(child.ID())
+			s.Require().NoError(err)
+			s.Require().NotZero(id)
+			byID[child.ID()] = child
+			stack = append(stack, child)
+		}
+	}
+	s.Require().Len(byID, len(original))
+
+	for val, want := range original {
+		got, ok := byID[want.ID()]
+		s.Require().True(ok, val)
+		s.Require().Equal(val, got.Val())
+		s.Require().Equal(want.Level(), got.Level(), val)
+		s.Require().Equal(want.MaxBreadth(), got.MaxBreadth(), val)
+		s.Require().Equal(want.Breadth(), got.Breadth(), val)
+		if want.HasParent() {
+			s.Require().Equal(want.Parent().ID(), got.Parent().ID(), val)
+		}
+	}
+
+	again, err := json.Marshal(&loaded)
+	s.Require().NoError(err)
+	s.Require().JSONEq(string(data), string(again))
+}
+
+func (s *NodeJSONTestSuite) TestRoundTrip_Subtree() {
+	_, byValue := s.buildTree()
+	cto := byValue["CTO"]
+
+	data, err := json.Marshal(cto)
+	s.Require().NoError(err)
+
+	var loaded Node[string]
+	s.Require().NoError(json.Unmarshal(data, &loaded))
+	s.Require().Equal(cto.ID(), loaded.ID())
+	s.Require().Equal(1, loaded.Level())
+	s.Require().False(loaded.IsRoot())
+	s.Require().False(loaded.HasParent())
+	s.Require().Equal(3, loaded.Breadth())
+	for _, child := range loaded.ChildrenIter() {
+		s.Require().Equal(2, child.Level())
+		s.Require().True(child.IsChildOf(&loaded))
+	}
+}
+
+func (s *NodeJSONTestSuite) TestMarshal_Leaf() {
+	n, err := NewNode[int](7, 2, ValueOpt(42))
+	s.Require().NoError(err)
+
+	data, err := json.Marshal(n)
+	s.Require().NoError(err)
+	s.Require().JSONEq(`{"id":7,"value":42,"max_breadth":2,"level":-1}`, string(data))
+
+	var loaded Node[int]
+	s.Require().NoError(json.Unmarshal(data, &loaded))
+	s.Require().True(loaded.IsDetached())
+	s.Require().Equal(-1, loaded.Level())
+	s.Require().Equal(42, loaded.Val())
+}
+
+func (s *NodeJSONTestSuite) TestUnmarshal_DuplicateSiblingID() {
+	data := `{"id":1,"value":"a","max_breadth":2,"level":0,"children":[
+		{"id":2,"value":"b","max_breadth":2,"level":1,"children":[
+			{"id":3,"value":"c","max_breadth":2,"level":2},
+			{"id":3,"value":"d","max_breadth":2,"level":2}
+		]}
+	]}`
+
+	loaded, err := NewNode[string](99, 1, ValueOpt("keep"))
+	s.Require().NoError(err)
+	err = json.Unmarshal([]byte(data), loaded)
+	s.Require().ErrorIs(err, ErrHierarchyModel)
+	s.Require().Equal(uint64(99), loaded.ID())
+	s.Require().Equal("keep", loaded.Val())
+}
+
+func (s *NodeJSONTestSuite) TestRoundTrip_RepeatedIDsAcrossBranches() {
+	root, err := NewNode[string](0, 2, ValueOpt("root"), LevelOpt[string](0))
+	s.Require().NoError(err)
+	a, err := NewNode[string](1, 2, ValueOpt("a"), ParentOpt(root))
+	s.Require().NoError(err)
+	b, err := NewNode[string](2, 2, ValueOpt("b"), ParentOpt(root))
+	s.Require().NoError(err)
+	_, err = NewNode[string](5, 2, ValueOpt("a5"), ParentOpt(a))
+	s.Require().NoError(err)
+	_, err = NewNode[string](1, 2, ValueOpt("b1"), ParentOpt(b))
+	s.Require().NoError(err)
+
+	data, err := json.Marshal(root)
+	s.Require().NoError(err)
+
+	var encoded nodeJSON[string]
+	s.Require().NoError(json.Unmarshal(data, &encoded))
+	s.Require().Len(encoded.Children, 2)
+	s.Require().Equal("a", encoded.Children[0].Value)
+	s.Require().Len(encoded.Children[0].Children, 1)
+	s.Require().Equal("a5", encoded.Children[0].Children[0].Value)
+	s.Require().Equal("b", encoded.Children[1].Value)
+	s.Require().Len(encoded.Children[1].Children, 1)
+	s.Require().Equal("b1", encoded.Children[1].Children[0].Value)
+
+	var loaded Node[string]
+	s.Require().NoError(json.Unmarshal(data, &loaded))
+	roundTrip, err := json.Marshal(&loaded)
+	s.Require().NoError(err)
+	s.Require().JSONEq(string(data), string(roundTrip))
+	s.Require().True(Equal(&loaded, root))
+}
+
+func (s *NodeJSONTestSuite) TestUnmarshal_MaxBreadth() {
+	data := `{"id":1,"value":"a","max_breadth":1,"level":0,"children":[
+		{"id":2,"value":"b","max_breadth":1,"level":1},
+		{"id":3,"value":"c","max_breadth":1,"level":1}
+	]}`
+
+	var loaded Node[string]
+	s.Require().ErrorIs(json.Unmarshal([]byte(data), &loaded), ErrMaxBreadth)
+}
+
+func (s *NodeJSONTestSuite) TestUnmarshal_Invalid() {
+	var loaded Node[string]
+	s.Require().Error(json.Unmarshal([]byte(`{"id":"x"}`), &loaded))
+}
@@ -0,0 +1,213 @@
+package tree
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// MinMaxHeap is a double-ended priority queue that gives O(1) access to both
+// its smallest and largest element and removes either in O(log n).
+//
+// Elements are stored in level-order like Heap, but levels alternate in
+// role: every element on an even level (the root is level 0) is no greater
+// than any of its descendants, and every element on an odd level is no
+// smaller than any of its descendants. The minimum is therefore the root and
+// the maximum is one of its children.
+//
+// Common use cases:
+//   - Bounded top-K buffers that evict from one end while reading the other
+//   - Sliding medians and interval scheduling
+type MinMaxHeap[T cmp.Ordered] struct {
+	data []T
+}
+
+// NewMinMaxHeap creates a new empty min-max heap.
+//
+// Example:
+//
+//	h := NewMinMaxHeap[int]()
+func NewMinMaxHeap[T cmp.Ordered]() *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{}
+}
+
+// Push adds a new element to the heap.
+// Time complexity: O(log n)
+func (h *MinMaxHeap[T]) Push(value T) {
+	h.data = append(h.data, value)
+
+	i := len(h.data) - 1
+	if i == 0 {
+		return
+	}
+
+	parent := (i - 1) / 2
+	switch {
+	case isMinLevel(i) && h.data[i] > h.data[parent]:
+		h.swap(i, parent)
+		h.bubbleUp(parent, mmGreater[T])
+	case !isMinLevel(i) && h.data[i] < h.data[parent]:
+		h.swap(i, parent)
+		h.bubbleUp(parent, mmLess[T])
+	case isMinLevel(i):
+		h.bubbleUp(i, mmLess[T])
+	default:
+		h.bubbleUp(i, mmGreater[T])
+	}
+}
+
+// PopMin removes and returns the smallest element.
+// Returns the element and true if successful, or zero value and false if heap is empty.
+// Time complexity: O(log n)
+func (h *MinMaxHeap[T]) PopMin() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.removeAt(0), true
+}
+
+// PopMax removes and returns the largest element.
+// Returns the element and true if successful, or zero value and false if heap is empty.
+// Time complexity: O(log n)
+func (h *MinMaxHeap[T]) PopMax() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.removeAt(h.maxIndex()), true
+}
+
+// PeekMin returns the smallest element without removing it.
+// Returns the element and true if successful, or zero value and false if heap is empty.
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) PeekMin() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.data[0], true
+}
+
+// PeekMax returns the largest element without removing it.
+// Returns the element and true if successful, or zero value and false if heap is empty.
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) PeekMax() (T, bool) {
+	if len(h.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.data[h.maxIndex()], true
+}
+
+// Len returns the number of elements in the heap.
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) Len() int {
+	return len(h.data)
+}
+
+// IsEmpty returns true if the heap contains no elements.
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) IsEmpty() bool {
+	return len(h.data) == 0
+}
+
+// Clear removes all elements from the heap.
+// Time complexity: O(1)
+func (h *MinMaxHeap[T]) Clear() {
+	h.data = h.data[:0]
+}
+
+// isMinLevel reports whether index i lies on an even (min) level.
+func isMinLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+// maxIndex returns the index of the largest element in a non-empty heap.
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch len(h.data) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	default:
+		if h.data[2] > h.data[1] {
+			return 2
+		}
+		return 1
+	}
+}
+
+// removeAt replaces the element at i with the last element and restores the heap.
+func (h *MinMaxHeap[T]) removeAt(i int) T {
+	removed := h.data[i]
+	last := len(h.data) - 1
+	h.data[i] = h.data[last]
+	h.data = h.data[:last]
+
+	if i < len(h.data) {
+		if isMinLevel(i) {
+			h.trickleDown(i, mmLess[T])
+		} else {
+			h.trickleDown(i, mmGreater[T])
+		}
+	}
+
+	return removed
+}
+
+// bubbleUp moves the element at i up through its grandparents while it
+// beats them under better.
+func (h *MinMaxHeap[T]) bubbleUp(i int, better func(a, b T) bool) {
+	for i >= 3 {
+		grandparent := ((i-1)/2 - 1) / 2
+		if !better(h.data[i], h.data[grandparent]) {
+			return
+		}
+		h.swap(i, grandparent)
+		i = grandparent
+	}
+}
+
+// trickleDown restores the heap below i, where better orders i's level
+// (mmLess on min levels, mmGreater on max levels).
+func (h *MinMaxHeap[T]) trickleDown(i int, better func(a, b T) bool) {
+	n := len(h.data)
+	for {
+		// Find the best among children and grandchildren.
+		best := -1
+		first := 2*i + 1
+		for _, c := range [...]int{first, first + 1, 2*first + 1, 2*first + 2, 2*first + 3, 2*first + 4} {
+			if c < n && (best < 0 || better(h.data[c], h.data[best])) {
+				best = c
+			}
+		}
+
+		if best < 0 || !better(h.data[best], h.data[i]) {
+			return
+		}
+		h.swap(i, best)
+
+		if best <= first+1 {
+			return // a direct child has no descendants to fix
+		}
+
+		// A grandchild moved down to best's level; make sure it still
+		// respects its parent on the opposite level.
+		if parent := (best - 1) / 2; better(h.data[parent], h.data[best]) {
+			h.swap(best, parent)
+		}
+		i = best
+	}
+}
+
+func mmLess[T cmp.Ordered](a, b T) bool {
+	return a < b
+}
+
+func mmGreater[T cmp.Ordered](a, b T) bool {
+	return a > b
+}
+
+func (h *MinMaxHeap[T]) swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+}
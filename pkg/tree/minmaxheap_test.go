@@ -0,0 +1,169 @@
+package tree
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// MinMaxHeapTestSuite tests double-ended priority queue functionality
+type MinMaxHeapTestSuite struct {
+	suite.Suite
+}
+
+// checkMinMaxInvariant verifies that every element respects all of its
+// descendants according to the role of its level.
+func (s *MinMaxHeapTestSuite) checkMinMaxInvariant(h *MinMaxHeap[int]) {
+	for i := range h.data {
+		for p := (i - 1) / 2; i > 0; p = (p - 1) / 2 {
+			if isMinLevel(p) {
+				s.Require().LessOrEqual(h.data[p], h.data[i], "min ancestor %d of %d", p, i)
+			} else {
+				s.Require().GreaterOrEqual(h.data[p], h.data[i], "max ancestor %d of %d", p, i)
+			}
+			if p == 0 {
+				break
+			}
+		}
+	}
+}
+
+func (s *MinMaxHeapTestSuite) TestEmpty() {
+	h := NewMinMaxHeap[int]()
+
+	s.Require().True(h.IsEmpty())
+	s.Require().Equal(0, h.Len())
+
+	for _, op := range []func() (int, bool){h.PeekMin, h.PeekMax, h.PopMin, h.PopMax} {
+		v, ok := op()
+		s.Require().False(ok)
+		s.Require().Zero(v)
+	}
+}
+
+func (s *MinMaxHeapTestSuite) TestSmallHeaps() {
+	h := NewMinMaxHeap[int]()
+
+	h.Push(5)
+	minV, _ := h.PeekMin()
+	maxV, _ := h.PeekMax()
+	s.Require().Equal(5, minV)
+	s.Require().Equal(5, maxV)
+
+	h.Push(3)
+	minV, _ = h.PeekMin()
+	maxV, _ = h.PeekMax()
+	s.Require().Equal(3, minV)
+	s.Require().Equal(5, maxV)
+
+	h.Push(9)
+	maxV, _ = h.PopMax()
+	s.Require().Equal(9, maxV)
+	maxV, _ = h.PopMax()
+	s.Require().Equal(5, maxV)
+	maxV, _ = h.PopMax()
+	s.Require().Equal(3, maxV)
+	s.Require().True(h.IsEmpty())
+}
+
+func (s *MinMaxHeapTestSuite) TestDrainBothEnds() {
+	values := []int{15, 3, 42, 8, 23, 4, 16, 1, 99, 7, 7, 50}
+	h := NewMinMaxHeap[int]()
+	for _, v := range values {
+		h.Push(v)
+		s.checkMinMaxInvariant(h)
+	}
+
+	sorted := slices.Sorted(slices.Values(values))
+	lo, hi := 0, len(sorted)-1
+	for lo <= hi {
+		v, ok := h.PopMin()
+		s.Require().True(ok)
+		s.Require().Equal(sorted[lo], v)
+		lo++
+		s.checkMinMaxInvariant(h)
+
+		if lo > hi {
+			break
+		}
+		v, ok = h.PopMax()
+		s.Require().True(ok)
+		s.Require().Equal(sorted[hi], v)
+		hi--
+		s.checkMinMaxInvariant(h)
+	}
+	s.Require().True(h.IsEmpty())
+}
+
+func (s *MinMaxHeapTestSuite) TestInterleavedAgainstSortedReference() {
+	rng := rand.New(rand.NewPCG(9, 10))
+	h := NewMinMaxHeap[int]()
+	var ref []int
+
+	for i := 0; i < 5000; i++ {
+		switch op := rng.IntN(4); {
+		case op < 2 || len(ref) == 0:
+			v := rng.IntN(1000)
+			h.Push(v)
+			idx, _ := slices.BinarySearch(ref, v)
+			ref = slices.Insert(ref, idx, v)
+		case op == 2:
+			v, ok := h.PopMin()
+			s.Require().True(ok)
+			s.Require().Equal(ref[0], v)
+			ref = ref[1:]
+		default:
+			v, ok := h.PopMax()
+			s.Require().True(ok)
+			s.Require().Equal(ref[len(ref)-1], v)
+			ref = ref[:len(ref)-1]
+		}
+
+		s.Require().Equal(len(ref), h.Len())
+		if len(ref) > 0 {
+			minV, _ := h.PeekMin()
+			maxV, _ := h.PeekMax()
+			s.Require().Equal(ref[0], minV)
+			s.Require().Equal(ref[len(ref)-1], maxV)
+		}
+		if i%250 == 0 {
+			s.checkMinMaxInvariant(h)
+		}
+	}
+}
+
+func (s *MinMaxHeapTestSuite) TestBoundedTopK() {
+	const k = 5
+	h := NewMinMaxHeap[int]()
+	for v := range 100 {
+		h.Push((v * 37) % 101)
+		if h.Len() > k {
+			h.PopMin()
+		}
+	}
+
+	var top []int
+	for !h.IsEmpty() {
+		v, _ := h.PopMax()
+		top = append(top, v)
+	}
+	s.Require().Equal([]int{100, 99, 98, 97, 96}, top)
+}
+
+func (s *MinMaxHeapTestSuite) TestClear() {
+	h := NewMinMaxHeap[string]()
+	h.Push("b")
+	h.Push("a")
+	h.Clear()
+
+	s.Require().True(h.IsEmpty())
+	h.Push("c")
+	v, _ := h.PeekMax()
+	s.Require().Equal("c", v)
+}
+
+func TestMinMaxHeapTestSuite(t *testing.T) {
+	suite.Run(t, new(MinMaxHeapTestSuite))
+}
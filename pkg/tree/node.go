@@ -12,6 +12,7 @@
 package tree
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -165,6 +166,54 @@ func (n *Node[T]) asRoot() bool {
 	return true
 }
 
+// shallowCopy returns a copy of the node's own fields without parent or children links.
+func (n *Node[T]) shallowCopy() *Node[T] {
+	return &Node[T]{
+		id:         n.id,
+		level:      n.level,
+		maxBreadth: n.maxBreadth,
+		state:      n.state,
+		val:        n.val,
+		children:   make(map[uint64]*Node[T], n.maxBreadth),
+	}
+}
+
+// Clone returns a deep copy of the subtree rooted at n. Every node is copied into
+// a fresh *Node[T] with the same ID, value, max breadth and level, and the
+// parent/child links are rebuilt among the copies, so attaching or detaching
+// nodes in the clone never affects the original and vice versa.
+//
+// Node IDs are preserved, so a node of the clone can be located with the same ID
+// as its source (for example via FindByID). Use Map with an identity function to
+// copy a subtree under freshly generated IDs instead.
+//
+// The copy of n has no parent. It stays a root if n is a root and is otherwise
+// detached, keeping n's level so that the levels below it remain unchanged.
+func (n *Node[T]) Clone() *Node[T] {
+	c := n.shallowCopy()
+	if c.state != root {
+		c.state = detached
+	}
+
+	type frame struct {
+		src, dst *Node[T]
+	}
+
+	stack := []frame{{src: n, dst: c}}
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for relID, child := range top.src.children {
+			copied := child.shallowCopy()
+			copied.parent = top.dst
+			top.dst.children[relID] = copied
+			stack = append(stack, frame{src: child, dst: copied})
+		}
+	}
+
+	return c
+}
+
 func (n *Node[T]) ID() uint64 {
 	return n.id
 }
@@ -295,6 +344,16 @@ func (n *Node[T]) ChildrenIter() iter.Seq2[uint64, *Node[T]] {
 	}
 }
 
+// sortedChildren returns the node's children ordered by ID.
+func (n *Node[T]) sortedChildren() []*Node[T] {
+	children := make([]*Node[T], 0, len(n.children))
+	for _, child := range n.children {
+		children = append(children, child)
+	}
+	slices.SortFunc(children, func(a, b *Node[T]) int { return cmp.Compare(a.id, b.id) })
+	return children
+}
+
 func (n *Node[T]) DetachChild(child *Node[T]) error {
 	if child == nil {
 		return fmt.Errorf("nil child node:%w", ErrNil)
@@ -311,6 +370,27 @@ func (n *Node[T]) DetachChild(child *Node[T]) error {
 	return nil
 }
 
+// Prune detaches child from n and returns every node that left the tree with it:
+// the child first, followed by its descendants in depth-first order.
+// Returns ErrNil for a nil child and ErrNodeNotFound if child isn't a direct child of n.
+func (n *Node[T]) Prune(child *Node[T]) ([]*Node[T], error) {
+	if child == nil {
+		return nil, fmt.Errorf("nil child node: %w", ErrNil)
+	}
+
+	if existing, exists := n.children[serial.NSum(n.id, child.id)]; !exists || existing != child {
+		return nil, ErrNodeNotFound
+	}
+
+	child.Detach()
+	removed := make([]*Node[T], 0, 1)
+	for pruned := range child.DescendantsIter() {
+		removed = append(removed, pruned)
+	}
+
+	return removed, nil
+}
+
 func (n *Node[T]) DetachChildFunc(successorFn NodeSuccessorFunc[T]) int {
 	if successorFn == nil {
 		return 0
@@ -3,6 +3,7 @@ package tree
 import (
 	"context"
 	"math"
+	"strconv"
 	"testing"
 	"time"
 
@@ -35,6 +36,24 @@ func (s *NodeTestSuite) nextDefaultGroupID() uint64 {
 	return s.nextGroupID(testDefaultGroup)
 }
 
+func (s *NodeTestSuite) ceoHierarchy() (*Node[string], map[string]*Node[string]) {
+	model := HierarchyModel{
+		RootTag: {"CEO"},
+		"CEO":   {"CTO", "CFO"},
+		"CTO":   {"PSA", "PSE", "DM"},
+		"CFO":   {"SEM", "PA"},
+	}
+	ceo, err := Hierarchy(model, 10, s.nextDefaultGroupID)
+	s.Require().NoError(err)
+
+	byValue := make(map[string]*Node[string])
+	for n := range ceo.DescendantsIter() {
+		byValue[n.Val()] = n
+	}
+
+	return ceo, byValue
+}
+
 func (s *NodeTestSuite) TestNewNode() {
 	id := s.nextDefaultGroupID()
 	n, err := NewNode[int](id, 0)
@@ -959,3 +978,510 @@ func (s *NodeTestSuite) TestNode_HasChild_EmptyChildren() {
 
 	s.False(parent.HasChild(child))
 }
+
+func (s *NodeTestSuite) TestNode_Height() {
+	ceo, nodes := s.ceoHierarchy()
+
+	s.Equal(2, ceo.Height())
+	s.Equal(1, nodes["CTO"].Height())
+	s.Equal(1, nodes["CFO"].Height())
+	s.Equal(0, nodes["DM"].Height())
+
+	// deepen a single branch
+	intern, err := NewNode[string](s.nextDefaultGroupID(), 1, ValueOpt("intern"), ParentOpt(nodes["PA"]))
+	s.Require().NoError(err)
+	s.Equal(3, ceo.Height())
+	s.Equal(2, nodes["CFO"].Height())
+	s.Equal(1, nodes["CTO"].Height())
+	s.Equal(0, intern.Height())
+}
+
+func (s *NodeTestSuite) TestNode_Depth() {
+	ceo, nodes := s.ceoHierarchy()
+
+	s.Equal(0, ceo.Depth())
+	s.Equal(1, nodes["CTO"].Depth())
+	s.Equal(2, nodes["PSE"].Depth())
+	s.Equal(2, nodes["SEM"].Depth())
+
+	detached, err := NewNode[string](s.nextDefaultGroupID(), 0)
+	s.Require().NoError(err)
+	s.Equal(0, detached.Depth())
+}
+
+func (s *NodeTestSuite) TestNode_Depth_StaleLevel() {
+	ceo, nodes := s.ceoHierarchy()
+
+	// Move only updates the level of the moved node, not of its descendants
+	cfo := nodes["CFO"]
+	s.Require().NoError(cfo.Move(nodes["DM"]))
+	s.Equal(3, cfo.Depth())
+	s.Equal(4, nodes["SEM"].Depth())
+	s.Equal(2, nodes["SEM"].Level())
+	s.Equal(4, ceo.Height())
+}
+
+func (s *NodeTestSuite) TestNode_Size() {
+	ceo, nodes := s.ceoHierarchy()
+
+	s.Equal(8, ceo.Size())
+	s.Equal(4, nodes["CTO"].Size())
+	s.Equal(3, nodes["CFO"].Size())
+	s.Equal(1, nodes["PA"].Size())
+
+	nodes["CFO"].Detach()
+	s.Equal(5, ceo.Size())
+	s.Equal(3, nodes["CFO"].Size())
+
+	leaf, err := NewNode[string](s.nextDefaultGroupID(), 0)
+	s.Require().NoError(err)
+	s.Equal(1, leaf.Size())
+}
+
+func (s *NodeTestSuite) TestNode_CountFunc() {
+	box, err := NewNode[string](s.nextDefaultGroupID(), 5, ValueOpt("boxOfFruits"))
+	s.Require().NoError(err)
+	for _, fruit := range []string{"apple", "orange", "apple", "orange", "orange"} {
+		_, err := NewNode[string](s.nextDefaultGroupID(), 0, ValueOpt(fruit), ParentOpt(box))
+		s.Require().NoError(err)
+	}
+
+	isOrange := func(fruit *Node[string]) bool {
+		return fruit.Val() == "orange"
+	}
+	s.Equal(3, box.CountFunc(isOrange))
+	s.Equal(2, box.CountFunc(func(fruit *Node[string]) bool {
+		return fruit.Val() == "apple"
+	}))
+	s.Equal(6, box.CountFunc(func(*Node[string]) bool { return true }))
+	s.Equal(0, box.CountFunc(nil))
+}
+
+func (s *NodeTestSuite) TestLCA() {
+	_, nodes := s.ceoHierarchy()
+
+	cases := []struct {
+		a, b, want string
+	}{
+		{"PSA", "DM", "CTO"},
+		{"PSA", "SEM", "CEO"},
+		{"CTO", "PA", "CEO"},
+		{"CTO", "PSE", "CTO"},
+		{"PSE", "CTO", "CTO"},
+		{"SEM", "SEM", "SEM"},
+		{"CEO", "PA", "CEO"},
+	}
+	for _, c := range cases {
+		lca, err := LCA(nodes[c.a], nodes[c.b])
+		s.Require().NoError(err, "%s/%s", c.a, c.b)
+		s.Equal(nodes[c.want], lca, "%s/%s", c.a, c.b)
+	}
+}
+
+func (s *NodeTestSuite) TestLCA_Disjoint() {
+	_, nodes := s.ceoHierarchy()
+	_, other := s.ceoHierarchy()
+
+	lca, err := LCA(nodes["PSA"], other["PSA"])
+	s.Require().ErrorIs(err, ErrNoMatch)
+	s.Nil(lca)
+
+	nodes["CFO"].Detach()
+	lca, err = LCA(nodes["PSA"], nodes["SEM"])
+	s.Require().ErrorIs(err, ErrNoMatch)
+	s.Nil(lca)
+}
+
+func (s *NodeTestSuite) TestLCA_Nil() {
+	_, nodes := s.ceoHierarchy()
+
+	_, err := LCA(nil, nodes["CTO"])
+	s.Require().ErrorIs(err, ErrNil)
+
+	_, err = LCA(nodes["CTO"], nil)
+	s.Require().ErrorIs(err, ErrNil)
+}
+
+func (s *NodeTestSuite) TestNode_PathToRoot() {
+	ceo, nodes := s.ceoHierarchy()
+
+	s.Equal([]*Node[string]{nodes["PSE"], nodes["CTO"], ceo}, nodes["PSE"].PathToRoot())
+	s.Equal([]*Node[string]{ceo, nodes["CTO"], nodes["PSE"]}, nodes["PSE"].PathFromRoot())
+	s.Equal([]*Node[string]{nodes["CFO"], ceo}, nodes["CFO"].PathToRoot())
+	s.Equal([]*Node[string]{ceo}, ceo.PathToRoot())
+	s.Equal([]*Node[string]{ceo}, ceo.PathFromRoot())
+}
+
+func (s *NodeTestSuite) TestNode_PathToRoot_Detached() {
+	_, nodes := s.ceoHierarchy()
+
+	detached, err := NewNode[string](s.nextDefaultGroupID(), 0)
+	s.Require().NoError(err)
+	s.Equal([]*Node[string]{detached}, detached.PathToRoot())
+	s.Equal([]*Node[string]{detached}, detached.PathFromRoot())
+
+	// a detached subtree ends at its own top node
+	nodes["CFO"].Detach()
+	s.Equal([]*Node[string]{nodes["PA"], nodes["CFO"]}, nodes["PA"].PathToRoot())
+}
+
+func (s *NodeTestSuite) TestNode_Root() {
+	ceo, nodes := s.ceoHierarchy()
+
+	for _, n := range nodes {
+		s.Same(ceo, n.Root(), n.Val())
+	}
+
+	detached, err := NewNode[string](s.nextDefaultGroupID(), 0)
+	s.Require().NoError(err)
+	s.Same(detached, detached.Root())
+
+	// a detached subtree has its own top node
+	nodes["CFO"].Detach()
+	s.Same(nodes["CFO"], nodes["PA"].Root())
+	s.Same(ceo, nodes["PSA"].Root())
+}
+
+func (s *NodeTestSuite) TestNode_Siblings() {
+	ceo, nodes := s.ceoHierarchy()
+
+	s.Equal([]*Node[string]{nodes["PSE"], nodes["DM"]}, nodes["PSA"].Siblings())
+	s.Equal([]*Node[string]{nodes["PSA"], nodes["DM"]}, nodes["PSE"].Siblings())
+	s.Equal([]*Node[string]{nodes["CTO"]}, nodes["CFO"].Siblings())
+
+	s.NotNil(ceo.Siblings())
+	s.Empty(ceo.Siblings())
+
+	// an only child has no siblings
+	s.Require().NoError(nodes["SEM"].Move(nodes["PSA"]))
+	s.Empty(nodes["SEM"].Siblings())
+
+	nodes["CFO"].Detach()
+	s.Empty(nodes["CFO"].Siblings())
+	s.Empty(nodes["CTO"].Siblings())
+}
+
+func (s *NodeTestSuite) TestNode_Prune() {
+	ceo, nodes := s.ceoHierarchy()
+
+	removed, err := ceo.Prune(nodes["CTO"])
+	s.Require().NoError(err)
+	s.Require().Len(removed, 4)
+	s.Equal(nodes["CTO"], removed[0])
+	s.ElementsMatch([]*Node[string]{nodes["CTO"], nodes["PSA"], nodes["PSE"], nodes["DM"]}, removed)
+
+	s.True(nodes["CTO"].IsDetached())
+	s.False(ceo.HasChild(nodes["CTO"]))
+	s.Equal(4, ceo.Size())
+	s.True(nodes["DM"].IsChildOf(nodes["CTO"]))
+
+	leaves, err := nodes["CFO"].Prune(nodes["PA"])
+	s.Require().NoError(err)
+	s.Equal([]*Node[string]{nodes["PA"]}, leaves)
+}
+
+func (s *NodeTestSuite) TestNode_Prune_Errors() {
+	ceo, nodes := s.ceoHierarchy()
+
+	_, err := ceo.Prune(nil)
+	s.Require().ErrorIs(err, ErrNil)
+
+	// grandchildren are not direct children
+	_, err = ceo.Prune(nodes["PSA"])
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.True(nodes["PSA"].IsAttached())
+
+	// a different node sharing the child's ID is not the child
+	impostor, err := NewNode[string](nodes["CTO"].ID(), 0)
+	s.Require().NoError(err)
+	_, err = ceo.Prune(impostor)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+	s.True(ceo.HasChild(nodes["CTO"]))
+}
+
+func (s *NodeTestSuite) TestMap() {
+	ceo, nodes := s.ceoHierarchy()
+
+	lengths, err := Map(ceo, func(v string) int { return len(v) }, s.nextDefaultGroupID)
+	s.Require().NoError(err)
+	s.True(lengths.IsRoot())
+	s.Equal(3, lengths.Val())
+	s.Equal(ceo.Size(), lengths.Size())
+	s.Equal(ceo.Height(), lengths.Height())
+	s.NotEqual(ceo.ID(), lengths.ID())
+
+	titles, err := Map(ceo, func(v string) string { return "chief " + v }, s.nextDefaultGroupID)
+	s.Require().NoError(err)
+	byValue := make(map[string]*Node[string])
+	for n := range titles.DescendantsIter() {
+		byValue[n.Val()] = n
+	}
+	for val, original := range nodes {
+		mapped, ok := byValue["chief "+val]
+		s.Require().True(ok, val)
+		s.Equal(original.Level(), mapped.Level(), val)
+		s.Equal(original.MaxBreadth(), mapped.MaxBreadth(), val)
+		s.Equal(original.Breadth(), mapped.Breadth(), val)
+		if original.HasParent() {
+			s.True(mapped.IsChildOf(byValue["chief "+original.Parent().Val()]), val)
+		}
+	}
+
+	// the source tree is untouched
+	s.Equal("CTO", nodes["CTO"].Val())
+	s.True(nodes["CTO"].IsChildOf(ceo))
+}
+
+func (s *NodeTestSuite) TestMap_Subtree() {
+	_, nodes := s.ceoHierarchy()
+
+	mapped, err := Map(nodes["CFO"], func(v string) string { return v }, s.nextDefaultGroupID)
+	s.Require().NoError(err)
+	s.True(mapped.IsRoot())
+	s.Equal(0, mapped.Level())
+	s.Equal(3, mapped.Size())
+	for _, child := range mapped.ChildrenIter() {
+		s.Equal(1, child.Level())
+	}
+}
+
+func (s *NodeTestSuite) TestMap_RepeatingIDGen() {
+	newNode := func(id uint64, parent *Node[string]) *Node[string] {
+		opts := []NodeOption[string]{ValueOpt(strconv.FormatUint(id, 10))}
+		if parent != nil {
+			opts = append(opts, ParentOpt(parent))
+		}
+		n, err := NewNode[string](id, 2, opts...)
+		s.Require().NoError(err)
+		return n
+	}
+	root := newNode(1, nil)
+	two := newNode(2, root)
+	three := newNode(3, root)
+	newNode(4, two)
+	newNode(5, three)
+
+	repeating := func(ids ...uint64) func() uint64 {
+		return func() uint64 {
+			id := ids[0]
+			ids = ids[1:]
+			return id
+		}
+	}
+	identity := func(v string) string { return v }
+
+	// cousins may share an ID
+	mapped, err := Map(root, identity, repeating(10, 11, 12, 11, 11))
+	s.Require().NoError(err)
+	s.Equal(5, mapped.Size())
+	values := make(map[string]uint64)
+	for n := range mapped.DescendantsIter() {
+		values[n.Val()] = n.ID()
+	}
+	s.Equal(map[string]uint64{"1": 10, "2": 11, "3": 12, "4": 11, "5": 11}, values)
+
+	// siblings may not
+	_, err = Map(root, identity, repeating(10, 11, 11))
+	s.Require().ErrorIs(err, ErrHierarchyModel)
+}
+
+func (s *NodeTestSuite) TestMap_Nil() {
+	ceo, _ := s.ceoHierarchy()
+	identity := func(v string) string { return v }
+
+	_, err := Map[string, string](nil, identity, s.nextDefaultGroupID)
+	s.Require().ErrorIs(err, ErrNil)
+
+	_, err = Map[string, string](ceo, nil, s.nextDefaultGroupID)
+	s.Require().ErrorIs(err, ErrNil)
+
+	_, err = Map(ceo, identity, nil)
+	s.Require().ErrorIs(err, ErrNil)
+}
+
+func (s *NodeTestSuite) TestFold() {
+	ceo, nodes := s.ceoHierarchy()
+
+	order := Fold(ceo, []string{}, func(acc []string, n *Node[string]) []string {
+		return append(acc, n.Val())
+	})
+	s.Equal([]string{"PSA", "PSE", "DM", "CTO", "SEM", "PA", "CFO", "CEO"}, order)
+
+	chars := Fold(nodes["CFO"], 0, func(acc int, n *Node[string]) int {
+		return acc + len(n.Val())
+	})
+	s.Equal(len("SEM")+len("PA")+len("CFO"), chars)
+
+	s.Equal(1, Fold(nodes["PA"], 0, func(acc int, _ *Node[string]) int { return acc + 1 }))
+}
+
+func (s *NodeTestSuite) TestFold_Nil() {
+	ceo, _ := s.ceoHierarchy()
+
+	s.Equal(42, Fold(nil, 42, func(acc int, _ *Node[string]) int { return acc + 1 }))
+	s.Equal(42, Fold[string, int](ceo, 42, nil))
+}
+
+func (s *NodeTestSuite) TestFold_DeepHierarchy() {
+	const depth = 100_000
+	root, err := NewNode[int](s.nextDefaultGroupID(), 1, ValueOpt(1), LevelOpt[int](0))
+	s.Require().NoError(err)
+	parent := root
+	for i := 2; i <= depth; i++ {
+		parent, err = NewNode[int](s.nextDefaultGroupID(), 1, ValueOpt(i), ParentOpt(parent))
+		s.Require().NoError(err)
+	}
+
+	// post-order visits the deepest node first
+	first := Fold(root, 0, func(acc int, n *Node[int]) int {
+		if acc == 0 {
+			return n.Val()
+		}
+		return acc
+	})
+	s.Equal(depth, first)
+}
+
+func (s *NodeTestSuite) TestClone() {
+	ceo, _ := s.ceoHierarchy()
+
+	clone := ceo.Clone()
+	s.NotSame(ceo, clone)
+	s.True(Equal(ceo, clone))
+	s.True(clone.IsRoot())
+	s.Nil(clone.Parent())
+
+	originals := make(map[*Node[string]]bool)
+	for n := range ceo.DescendantsIter() {
+		originals[n] = true
+	}
+	for n := range clone.DescendantsIter() {
+		s.False(originals[n], "clone shares node %d with the original", n.ID())
+
+		src, err := ceo.FindByID(n.ID())
+		s.Require().NoError(err)
+		s.Equal(src.Val(), n.Val())
+		s.Equal(src.Level(), n.Level())
+		s.Equal(src.MaxBreadth(), n.MaxBreadth())
+		s.Equal(src.IsRoot(), n.IsRoot())
+		s.Equal(src.Breadth(), n.Breadth())
+		for _, child := range n.ChildrenIter() {
+			s.Same(n, child.Parent())
+			s.True(child.IsChildOf(n))
+		}
+	}
+}
+
+func (s *NodeTestSuite) TestClone_IsIndependent() {
+	ceo, nodes := s.ceoHierarchy()
+	clone := ceo.Clone()
+
+	cto, err := clone.FindByID(nodes["CTO"].ID())
+	s.Require().NoError(err)
+	s.Require().NoError(clone.DetachChild(cto))
+	cto.WithValue("changed")
+
+	s.True(nodes["CTO"].IsChildOf(ceo))
+	s.Equal("CTO", nodes["CTO"].Val())
+	s.Equal(2, ceo.Breadth())
+	s.Equal(1, clone.Breadth())
+
+	extra, err := NewNode[string](s.nextDefaultGroupID(), 1, ValueOpt("extra"))
+	s.Require().NoError(err)
+	s.Require().NoError(ceo.AttachChild(extra))
+	s.Equal(1, clone.Breadth())
+}
+
+func (s *NodeTestSuite) TestClone_Subtree() {
+	_, nodes := s.ceoHierarchy()
+
+	cfo := nodes["CFO"].Clone()
+	s.Equal("CFO", cfo.Val())
+	s.Nil(cfo.Parent())
+	s.False(cfo.IsRoot())
+	s.Equal(nodes["CFO"].Level(), cfo.Level())
+	s.True(Equal(nodes["CFO"], cfo))
+	for _, child := range cfo.ChildrenIter() {
+		s.Equal(cfo.Level()+1, child.Level())
+	}
+
+	leaf := nodes["PA"].Clone()
+	s.Equal("PA", leaf.Val())
+	s.False(leaf.HasChildren())
+}
+
+func (s *NodeTestSuite) TestEqual() {
+	a, _ := s.ceoHierarchy()
+	b, _ := s.ceoHierarchy()
+	s.True(Equal(a, b))
+	s.True(Equal(a, a))
+
+	// attach order does not matter
+	reordered, err := Hierarchy(HierarchyModel{
+		RootTag: {"CEO"},
+		"CEO":   {"CFO", "CTO"},
+		"CTO":   {"DM", "PSE", "PSA"},
+		"CFO":   {"PA", "SEM"},
+	}, 10, s.nextDefaultGroupID)
+	s.Require().NoError(err)
+	s.True(Equal(a, reordered))
+
+	mapped, err := Map(a, func(v string) string { return v }, s.nextDefaultGroupID)
+	s.Require().NoError(err)
+	s.True(Equal(a, mapped))
+}
+
+func (s *NodeTestSuite) TestEqual_Different() {
+	a, nodes := s.ceoHierarchy()
+	b, other := s.ceoHierarchy()
+
+	other["PA"].WithValue("CPA")
+	s.False(Equal(a, b))
+	other["PA"].WithValue("PA")
+	s.True(Equal(a, b))
+
+	// same values, different shape
+	s.Require().NoError(other["SEM"].Move(other["PA"]))
+	s.False(Equal(a, b))
+
+	// a subtree is not equal to the whole tree
+	s.False(Equal(a, nodes["CTO"]))
+	s.True(Equal(nodes["CTO"], other["CTO"]))
+}
+
+func (s *NodeTestSuite) TestEqual_Multiset() {
+	build := func(groups ...[]string) *Node[string] {
+		root, err := NewNode[string](s.nextDefaultGroupID(), 5, ValueOpt("box"), LevelOpt[string](0))
+		s.Require().NoError(err)
+		for _, group := range groups {
+			child, err := NewNode[string](s.nextDefaultGroupID(), 5, ValueOpt(group[0]), ParentOpt(root))
+			s.Require().NoError(err)
+			for _, val := range group[1:] {
+				_, err := NewNode[string](s.nextDefaultGroupID(), 5, ValueOpt(val), ParentOpt(child))
+				s.Require().NoError(err)
+			}
+		}
+		return root
+	}
+
+	s.True(Equal(
+		build([]string{"apple", "seed"}, []string{"apple"}),
+		build([]string{"apple"}, []string{"apple", "seed"}),
+	))
+	s.False(Equal(
+		build([]string{"apple", "seed"}, []string{"apple"}),
+		build([]string{"apple", "seed"}, []string{"apple", "seed"}),
+	))
+	s.False(Equal(
+		build([]string{"apple"}, []string{"apple"}),
+		build([]string{"apple"}),
+	))
+}
+
+func (s *NodeTestSuite) TestEqual_Nil() {
+	a, _ := s.ceoHierarchy()
+
+	s.True(Equal[string](nil, nil))
+	s.False(Equal(a, nil))
+	s.False(Equal(nil, a))
+}
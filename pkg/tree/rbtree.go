@@ -0,0 +1,422 @@
+package tree
+
+import (
+	"cmp"
+	"iter"
+)
+
+const (
+	rbRed   rbColor = false
+	rbBlack rbColor = true
+)
+
+type (
+	// rbColor is the color of a red-black tree node.
+	rbColor bool
+
+	// rbNode represents a node in the red-black tree.
+	rbNode[K cmp.Ordered, V any] struct {
+		entry  BTreeEntry[K, V]
+		color  rbColor
+		left   *rbNode[K, V]
+		right  *rbNode[K, V]
+		parent *rbNode[K, V]
+	}
+
+	// RBTree is a red-black tree: a self-balancing binary search tree that
+	// stores key-value pairs in sorted key order.
+	//
+	// Each node is colored red or black such that no red node has a red child
+	// and every root-to-leaf path holds the same number of black nodes. This
+	// bounds the height by 2·log2(n+1), so search, insert and delete are
+	// O(log n) in the worst case regardless of the input order.
+	//
+	// Thread Safety:
+	// RBTree is not thread-safe. Concurrent access requires external synchronization.
+	RBTree[K cmp.Ordered, V any] struct {
+		root *rbNode[K, V]
+		size int
+	}
+)
+
+// NewRBTree creates a new empty red-black tree.
+func NewRBTree[K cmp.Ordered, V any]() *RBTree[K, V] {
+	return &RBTree[K, V]{}
+}
+
+// Size returns the number of entries in the tree.
+func (t *RBTree[K, V]) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the tree contains no entries.
+func (t *RBTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Height returns the number of nodes on the longest root-to-leaf path.
+// Returns 0 for an empty tree.
+func (t *RBTree[K, V]) Height() int {
+	height := 0
+	level := []*rbNode[K, V]{}
+	if t.root != nil {
+		level = append(level, t.root)
+	}
+
+	for len(level) > 0 {
+		height++
+		var next []*rbNode[K, V]
+		for _, n := range level {
+			if n.left != nil {
+				next = append(next, n.left)
+			}
+			if n.right != nil {
+				next = append(next, n.right)
+			}
+		}
+		level = next
+	}
+
+	return height
+}
+
+// Insert adds a key-value pair to the tree.
+// If the key already exists, the value is updated.
+func (t *RBTree[K, V]) Insert(key K, value V) {
+	var parent *rbNode[K, V]
+	current := t.root
+
+	for current != nil {
+		parent = current
+		switch {
+		case key == current.entry.Key:
+			current.entry.Value = value
+			return
+		case key < current.entry.Key:
+			current = current.left
+		default:
+			current = current.right
+		}
+	}
+
+	n := &rbNode[K, V]{entry: BTreeEntry[K, V]{Key: key, Value: value}, color: rbRed, parent: parent}
+	switch {
+	case parent == nil:
+		t.root = n
+	case key < parent.entry.Key:
+		parent.left = n
+	default:
+		parent.right = n
+	}
+
+	t.size++
+	t.insertFixup(n)
+}
+
+// Search finds the value associated with the given key.
+// Returns the value and true if found, zero value and false otherwise.
+func (t *RBTree[K, V]) Search(key K) (V, bool) {
+	if n := t.find(key); n != nil {
+		return n.entry.Value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Contains returns true if the key exists in the tree.
+func (t *RBTree[K, V]) Contains(key K) bool {
+	return t.find(key) != nil
+}
+
+// Delete removes a key from the tree.
+// Returns true if the key was found and deleted, false otherwise.
+func (t *RBTree[K, V]) Delete(key K) bool {
+	z := t.find(key)
+	if z == nil {
+		return false
+	}
+
+	var x, xParent *rbNode[K, V]
+	removedColor := z.color
+
+	switch {
+	case z.left == nil:
+		x, xParent = z.right, z.parent
+		t.transplant(z, z.right)
+	case z.right == nil:
+		x, xParent = z.left, z.parent
+		t.transplant(z, z.left)
+	default:
+		// Move the in-order successor into z's position.
+		y := rbMin(z.right)
+		removedColor = y.color
+		x = y.right
+
+		if y.parent == z {
+			xParent = y
+		} else {
+			xParent = y.parent
+			t.transplant(y, y.right)
+			y.right = z.right
+			y.right.parent = y
+		}
+
+		t.transplant(z, y)
+		y.left = z.left
+		y.left.parent = y
+		y.color = z.color
+	}
+
+	t.size--
+	if removedColor == rbBlack {
+		t.deleteFixup(x, xParent)
+	}
+
+	return true
+}
+
+// Min returns the minimum key-value pair in the tree.
+// Returns zero values and false if the tree is empty.
+func (t *RBTree[K, V]) Min() (key K, value V, found bool) {
+	if t.root == nil {
+		return key, value, false
+	}
+
+	n := rbMin(t.root)
+	return n.entry.Key, n.entry.Value, true
+}
+
+// Max returns the maximum key-value pair in the tree.
+// Returns zero values and false if the tree is empty.
+func (t *RBTree[K, V]) Max() (key K, value V, found bool) {
+	if t.root == nil {
+		return key, value, false
+	}
+
+	n := t.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.entry.Key, n.entry.Value, true
+}
+
+// All returns an iterator over all entries in ascending key order.
+func (t *RBTree[K, V]) All() iter.Seq[BTreeEntry[K, V]] {
+	return func(yield func(BTreeEntry[K, V]) bool) {
+		if t.root == nil {
+			return
+		}
+
+		for n := rbMin(t.root); n != nil; n = rbNext(n) {
+			if !yield(n.entry) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over all entries with keys in [from, to].
+// The entries are yielded in ascending key order.
+func (t *RBTree[K, V]) Range(from, to K) iter.Seq[BTreeEntry[K, V]] {
+	return func(yield func(BTreeEntry[K, V]) bool) {
+		for n := t.lowerBound(from); n != nil && n.entry.Key <= to; n = rbNext(n) {
+			if !yield(n.entry) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all entries from the tree.
+func (t *RBTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// find returns the node holding key, or nil.
+func (t *RBTree[K, V]) find(key K) *rbNode[K, V] {
+	n := t.root
+	for n != nil {
+		switch {
+		case key == n.entry.Key:
+			return n
+		case key < n.entry.Key:
+			n = n.left
+		default:
+			n = n.right
+		}
+	}
+	return nil
+}
+
+// lowerBound returns the node with the smallest key >= key, or nil.
+func (t *RBTree[K, V]) lowerBound(key K) *rbNode[K, V] {
+	var candidate *rbNode[K, V]
+	n := t.root
+	for n != nil {
+		if n.entry.Key >= key {
+			candidate = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return candidate
+}
+
+// insertFixup restores the red-black properties after inserting the red node n.
+func (t *RBTree[K, V]) insertFixup(n *rbNode[K, V]) {
+	for n.parent != nil && n.parent.color == rbRed {
+		parent := n.parent
+		grandparent := parent.parent // exists, since a red node is never the root
+
+		if parent == grandparent.left {
+			uncle := grandparent.right
+			if rbIsRed(uncle) {
+				parent.color, uncle.color, grandparent.color = rbBlack, rbBlack, rbRed
+				n = grandparent
+				continue
+			}
+			if n == parent.right {
+				n = parent
+				t.rotateLeft(n)
+			}
+			n.parent.color = rbBlack
+			grandparent.color = rbRed
+			t.rotateRight(grandparent)
+		} else {
+			uncle := grandparent.left
+			if rbIsRed(uncle) {
+				parent.color, uncle.color, grandparent.color = rbBlack, rbBlack, rbRed
+				n = grandparent
+				continue
+			}
+			if n == parent.left {
+				n = parent
+				t.rotateRight(n)
+			}
+			n.parent.color = rbBlack
+			grandparent.color = rbRed
+			t.rotateLeft(grandparent)
+		}
+	}
+
+	t.root.color = rbBlack
+}
+
+// deleteFixup restores the red-black properties after a black node was
+// removed above x. x may be nil, so its parent is passed explicitly.
+func (t *RBTree[K, V]) deleteFixup(x, parent *rbNode[K, V]) {
+	for x != t.root && !rbIsRed(x) {
+		if x == parent.left {
+			sibling := parent.right
+			if rbIsRed(sibling) {
+				sibling.color, parent.color = rbBlack, rbRed
+				t.rotateLeft(parent)
+				sibling = parent.right
+			}
+			if !rbIsRed(sibling.left) && !rbIsRed(sibling.right) {
+				sibling.color = rbRed
+				x, parent = parent, parent.parent
+				continue
+			}
+			if !rbIsRed(sibling.right) {
+				sibling.left.color, sibling.color = rbBlack, rbRed
+				t.rotateRight(sibling)
+				sibling = parent.right
+			}
+			sibling.color, parent.color, sibling.right.color = parent.color, rbBlack, rbBlack
+			t.rotateLeft(parent)
+		} else {
+			sibling := parent.left
+			if rbIsRed(sibling) {
+				sibling.color, parent.color = rbBlack, rbRed
+				t.rotateRight(parent)
+				sibling = parent.left
+			}
+			if !rbIsRed(sibling.left) && !rbIsRed(sibling.right) {
+				sibling.color = rbRed
+				x, parent = parent, parent.parent
+				continue
+			}
+			if !rbIsRed(sibling.left) {
+				sibling.right.color, sibling.color = rbBlack, rbRed
+				t.rotateLeft(sibling)
+				sibling = parent.left
+			}
+			sibling.color, parent.color, sibling.left.color = parent.color, rbBlack, rbBlack
+			t.rotateRight(parent)
+		}
+		x = t.root
+	}
+
+	if x != nil {
+		x.color = rbBlack
+	}
+}
+
+// transplant replaces the subtree rooted at u with the subtree rooted at v.
+func (t *RBTree[K, V]) transplant(u, v *rbNode[K, V]) {
+	switch {
+	case u.parent == nil:
+		t.root = v
+	case u == u.parent.left:
+		u.parent.left = v
+	default:
+		u.parent.right = v
+	}
+
+	if v != nil {
+		v.parent = u.parent
+	}
+}
+
+// rotateLeft lifts n's right child above n.
+func (t *RBTree[K, V]) rotateLeft(n *rbNode[K, V]) {
+	pivot := n.right
+	n.right = pivot.left
+	if pivot.left != nil {
+		pivot.left.parent = n
+	}
+	t.transplant(n, pivot)
+	pivot.left = n
+	n.parent = pivot
+}
+
+// rotateRight lifts n's left child above n.
+func (t *RBTree[K, V]) rotateRight(n *rbNode[K, V]) {
+	pivot := n.left
+	n.left = pivot.right
+	if pivot.right != nil {
+		pivot.right.parent = n
+	}
+	t.transplant(n, pivot)
+	pivot.right = n
+	n.parent = pivot
+}
+
+// rbIsRed reports whether n is red; nil leaves count as black.
+func rbIsRed[K cmp.Ordered, V any](n *rbNode[K, V]) bool {
+	return n != nil && n.color == rbRed
+}
+
+// rbMin returns the leftmost node of the subtree rooted at n.
+func rbMin[K cmp.Ordered, V any](n *rbNode[K, V]) *rbNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// rbNext returns the in-order successor of n, or nil.
+func rbNext[K cmp.Ordered, V any](n *rbNode[K, V]) *rbNode[K, V] {
+	if n.right != nil {
+		return rbMin(n.right)
+	}
+
+	for n.parent != nil && n == n.parent.right {
+		n = n.parent
+	}
+	return n.parent
+}
@@ -0,0 +1,234 @@
+package tree
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// RBTreeTestSuite tests red-black tree operations
+type RBTreeTestSuite struct {
+	suite.Suite
+	tree *RBTree[int, string]
+}
+
+func (s *RBTreeTestSuite) SetupTest() {
+	s.tree = NewRBTree[int, string]()
+}
+
+// checkRBInvariants verifies ordering, parent links, coloring, equal black
+// heights and the 2·log2(n+1) height bound.
+func (s *RBTreeTestSuite) checkRBInvariants() {
+	var check func(n, parent *rbNode[int, string], lo, hi *int) (blackHeight, count int)
+	check = func(n, parent *rbNode[int, string], lo, hi *int) (int, int) {
+		if n == nil {
+			return 1, 0
+		}
+
+		key := n.entry.Key
+		s.Require().Same(parent, n.parent, "parent link of %d", key)
+		if lo != nil {
+			s.Require().Greater(key, *lo)
+		}
+		if hi != nil {
+			s.Require().Less(key, *hi)
+		}
+		if n.color == rbRed {
+			s.Require().False(rbIsRed(n.left), "red node %d has red left child", key)
+			s.Require().False(rbIsRed(n.right), "red node %d has red right child", key)
+		}
+
+		lb, lc := check(n.left, n, lo, &key)
+		rb, rc := check(n.right, n, &key, hi)
+		s.Require().Equal(lb, rb, "black height mismatch at %d", key)
+
+		if n.color == rbBlack {
+			lb++
+		}
+		return lb, lc + rc + 1
+	}
+
+	if s.tree.root != nil {
+		s.Require().Equal(rbBlack, s.tree.root.color)
+	}
+	_, count := check(s.tree.root, nil, nil, nil)
+	s.Require().Equal(s.tree.Size(), count)
+	s.Require().LessOrEqual(float64(s.tree.Height()), 2*math.Log2(float64(s.tree.Size()+1)))
+}
+
+func (s *RBTreeTestSuite) keys() []int {
+	var keys []int
+	for e := range s.tree.All() {
+		keys = append(keys, e.Key)
+	}
+	return keys
+}
+
+func (s *RBTreeTestSuite) TestEmpty() {
+	s.Require().True(s.tree.IsEmpty())
+	s.Require().Equal(0, s.tree.Height())
+	s.Require().False(s.tree.Delete(1))
+
+	_, found := s.tree.Search(1)
+	s.Require().False(found)
+	_, _, found = s.tree.Min()
+	s.Require().False(found)
+	_, _, found = s.tree.Max()
+	s.Require().False(found)
+	s.Require().Empty(s.keys())
+}
+
+func (s *RBTreeTestSuite) TestInsertAndSearch() {
+	s.tree.Insert(20, "twenty")
+	s.tree.Insert(10, "ten")
+	s.tree.Insert(30, "thirty")
+	s.tree.Insert(10, "TEN")
+
+	s.Require().Equal(3, s.tree.Size())
+	v, found := s.tree.Search(10)
+	s.Require().True(found)
+	s.Require().Equal("TEN", v, "inserting an existing key updates its value")
+	s.Require().True(s.tree.Contains(30))
+	s.Require().False(s.tree.Contains(25))
+
+	k, v, found := s.tree.Min()
+	s.Require().True(found)
+	s.Require().Equal(10, k)
+	s.Require().Equal("TEN", v)
+
+	k, v, found = s.tree.Max()
+	s.Require().True(found)
+	s.Require().Equal(30, k)
+	s.Require().Equal("thirty", v)
+
+	s.checkRBInvariants()
+}
+
+func (s *RBTreeTestSuite) TestRange() {
+	for i := 1; i <= 20; i++ {
+		s.tree.Insert(i*5, "")
+	}
+
+	collect := func(from, to int) []int {
+		var keys []int
+		for e := range s.tree.Range(from, to) {
+			keys = append(keys, e.Key)
+		}
+		return keys
+	}
+
+	s.Require().Equal([]int{25, 30, 35, 40}, collect(25, 40))
+	s.Require().Equal([]int{25, 30, 35}, collect(23, 38))
+	s.Require().Equal([]int{5}, collect(-10, 5))
+	s.Require().Equal([]int{100}, collect(100, 200))
+	s.Require().Empty(collect(41, 44))
+	s.Require().Empty(collect(40, 25))
+
+	var first []int
+	for e := range s.tree.Range(0, 100) {
+		first = append(first, e.Key)
+		if len(first) == 3 {
+			break
+		}
+	}
+	s.Require().Equal([]int{5, 10, 15}, first)
+}
+
+func (s *RBTreeTestSuite) TestAdversarialSequences() {
+	const n = 2048
+
+	s.Run("ascending", func() {
+		s.tree = NewRBTree[int, string]()
+		for i := 0; i < n; i++ {
+			s.tree.Insert(i, "")
+		}
+		s.checkRBInvariants()
+	})
+
+	s.Run("descending", func() {
+		s.tree = NewRBTree[int, string]()
+		for i := n; i > 0; i-- {
+			s.tree.Insert(i, "")
+		}
+		s.checkRBInvariants()
+	})
+
+	s.Run("delete every other then reinsert", func() {
+		s.tree = NewRBTree[int, string]()
+		for i := 0; i < n; i++ {
+			s.tree.Insert(i, "")
+		}
+		for i := 0; i < n; i += 2 {
+			s.Require().True(s.tree.Delete(i))
+		}
+		s.checkRBInvariants()
+		s.Require().Equal(n/2, s.tree.Size())
+
+		for i := 0; i < n; i += 2 {
+			s.tree.Insert(i, "")
+		}
+		s.checkRBInvariants()
+	})
+
+	s.Run("delete from the front", func() {
+		s.tree = NewRBTree[int, string]()
+		for i := 0; i < n; i++ {
+			s.tree.Insert(i, "")
+		}
+		for i := 0; i < n-1; i++ {
+			s.Require().True(s.tree.Delete(i))
+			if i%128 == 0 {
+				s.checkRBInvariants()
+			}
+		}
+		s.Require().Equal([]int{n - 1}, s.keys())
+	})
+}
+
+func (s *RBTreeTestSuite) TestRandomOperations() {
+	rng := rand.New(rand.NewPCG(7, 8))
+	present := make(map[int]bool)
+
+	for i := 0; i < 5000; i++ {
+		k := rng.IntN(1000)
+		if rng.IntN(2) == 0 {
+			s.Require().Equal(present[k], s.tree.Delete(k))
+			delete(present, k)
+		} else {
+			s.tree.Insert(k, "")
+			present[k] = true
+		}
+
+		if i%250 == 0 {
+			s.checkRBInvariants()
+		}
+	}
+
+	s.checkRBInvariants()
+	expected := make([]int, 0, len(present))
+	for k := range present {
+		expected = append(expected, k)
+	}
+	slices.Sort(expected)
+	s.Require().Equal(expected, s.keys())
+}
+
+func (s *RBTreeTestSuite) TestClear() {
+	for i := 0; i < 10; i++ {
+		s.tree.Insert(i, "")
+	}
+
+	s.tree.Clear()
+	s.Require().True(s.tree.IsEmpty())
+	s.Require().Empty(s.keys())
+
+	s.tree.Insert(1, "one")
+	s.checkRBInvariants()
+}
+
+func TestRBTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(RBTreeTestSuite))
+}
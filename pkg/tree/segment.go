@@ -2,6 +2,7 @@ package tree
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/barnowlsnest/go-datalib/pkg/list"
@@ -492,6 +493,54 @@ func (s *Segment[T]) Link(parentID, childID uint64) error {
 	return nil
 }
 
+// Move detaches the node nodeID from its current parent and attaches it under
+// newParentID. The moved node and all of its descendants are re-leveled and the
+// level map is updated accordingly. Moving a node under its current parent is a no-op.
+//
+// Returns an error and leaves the segment unchanged if:
+//   - Either ID is not in the segment (ErrNodesNotInSegment)
+//   - The node is the root, or newParentID is the node itself or one of its
+//     descendants (ErrHierarchyModel)
+//   - The new parent is not connected to the segment (ErrParentNotInSegment)
+//   - The moved subtree would exceed the segment's max depth (ErrSegmentMaxDepth)
+//   - The new parent has no room for another child (ErrMaxBreadth)
+func (s *Segment[T]) Move(nodeID, newParentID uint64) error {
+	n, nodeExists := s.nodeMap[nodeID]
+	newParent, parentExists := s.nodeMap[newParentID]
+
+	if !nodeExists || !parentExists {
+		return ErrNodesNotInSegment
+	}
+
+	if n.IsRoot() {
+		return fmt.Errorf("cannot move root node [%d]: %w", nodeID, ErrHierarchyModel)
+	}
+
+	if n.Parent() == newParent {
+		return nil
+	}
+
+	for p := newParent; p != nil; p = p.Parent() {
+		if p == n {
+			return fmt.Errorf("cannot move node [%d] under its own subtree: %w", nodeID, ErrHierarchyModel)
+		}
+	}
+
+	if newParent.Level() < 0 {
+		return fmt.Errorf("parent node [%d] is detached: %w", newParentID, ErrParentNotInSegment)
+	}
+
+	if newParent.Level()+1+n.Height() >= s.maxDepth {
+		return ErrSegmentMaxDepth
+	}
+
+	if err := newParent.verifyMaxBreadth(1); err != nil {
+		return err
+	}
+
+	return s.Link(newParentID, nodeID)
+}
+
 // Unlink breaks the parent-child relationship, keeping both nodes in the segment.
 // The child becomes detached (level -1, no parent) but remains in nodeMap.
 // Note: The child is removed from levelMap since it no longer has a valid level.
@@ -559,3 +608,213 @@ func (s *Segment[T]) SelectOne(predicate VisitorFunc[T]) (*Node[T], error) {
 	}
 	return nil, ErrNoMatch
 }
+
+// SelectByValue returns all nodes whose value equals val.
+func (s *Segment[T]) SelectByValue(val T) []*Node[T] {
+	return s.Select(func(n *Node[T]) bool {
+		return n.Val() == val
+	})
+}
+
+// SelectOneByValue returns the first node whose value equals val, or ErrNoMatch if none found.
+func (s *Segment[T]) SelectOneByValue(val T) (*Node[T], error) {
+	return s.SelectOne(func(n *Node[T]) bool {
+		return n.Val() == val
+	})
+}
+
+// ToAdjacency returns the structure of the segment as a parent ID → child IDs map
+// together with the root ID. Every node in the segment has an entry, leaves map to
+// an empty slice and child IDs are sorted ascending. Only children that are part of
+// the segment are listed. The root ID is 0 when the segment has no root.
+func (s *Segment[T]) ToAdjacency() (map[uint64][]uint64, uint64) {
+	adjacency := make(map[uint64][]uint64, len(s.nodeMap))
+	for id, n := range s.nodeMap {
+		children := make([]uint64, 0, n.Breadth())
+		for _, child := range n.ChildrenIter() {
+			if s.nodeMap[child.ID()] == child {
+				children = append(children, child.ID())
+			}
+		}
+		slices.Sort(children)
+		adjacency[id] = children
+	}
+
+	var rootID uint64
+	if s.root != nil {
+		rootID = s.root.ID()
+	}
+
+	return adjacency, rootID
+}
+
+// Clone returns an independent copy of the segment with the same alias, ID and
+// limits. Every node is copied into a fresh *Node[T] with its parent/child links
+// rebuilt among the copies, so mutating the clone never affects the original.
+func (s *Segment[T]) Clone() *Segment[T] {
+	c := &Segment[T]{
+		alias:      s.alias,
+		id:         s.id,
+		maxDepth:   s.maxDepth,
+		maxBreadth: s.maxBreadth,
+		cap:        s.cap,
+		levelMap:   make(map[int][]uint64, len(s.levelMap)),
+		nodeMap:    make(map[uint64]*Node[T], len(s.nodeMap)),
+	}
+
+	for id, n := range s.nodeMap {
+		c.nodeMap[id] = n.shallowCopy()
+	}
+	for id, n := range s.nodeMap {
+		parent := c.nodeMap[id]
+		for relID, child := range n.children {
+			if copied, exists := c.nodeMap[child.id]; exists && s.nodeMap[child.id] == child {
+				parent.children[relID] = copied
+				copied.parent = parent
+			}
+		}
+	}
+	for level, ids := range s.levelMap {
+		c.levelMap[level] = slices.Clone(ids)
+	}
+	if s.root != nil {
+		c.root = c.nodeMap[s.root.id]
+	}
+
+	return c
+}
+
+// Path returns the tree path connecting the nodes fromID and toID, ordered from
+// fromID to toID and including both ends. The path climbs from fromID to the lowest
+// common ancestor of the two nodes and then descends to toID.
+//
+// Returns ErrNodeNotFound if either ID is not in the segment, or ErrNoMatch if the
+// nodes are not connected (e.g. one of them was unlinked).
+func (s *Segment[T]) Path(fromID, toID uint64) ([]*Node[T], error) {
+	from, fromExists := s.nodeMap[fromID]
+	if !fromExists {
+		return nil, fmt.Errorf("path from node [%d]: %w", fromID, ErrNodeNotFound)
+	}
+	to, toExists := s.nodeMap[toID]
+	if !toExists {
+		return nil, fmt.Errorf("path to node [%d]: %w", toID, ErrNodeNotFound)
+	}
+
+	ancestor, err := LCA(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	path := make([]*Node[T], 0, from.Depth()+to.Depth()-2*ancestor.Depth()+1)
+	for n := from; n != ancestor; n = n.Parent() {
+		path = append(path, n)
+	}
+	path = append(path, ancestor)
+
+	descent := len(path)
+	for n := to; n != ancestor; n = n.Parent() {
+		path = append(path, n)
+	}
+	slices.Reverse(path[descent:])
+
+	return path, nil
+}
+
+// Ancestors returns the lineage of the node nodeID, ordered from its parent up to
+// the root of its tree. The root itself has no ancestors and yields an empty slice.
+//
+// Returns ErrNodeNotFound if nodeID is not in the segment.
+func (s *Segment[T]) Ancestors(nodeID uint64) ([]*Node[T], error) {
+	n, exists := s.nodeMap[nodeID]
+	if !exists {
+		return nil, fmt.Errorf("ancestors of node [%d]: %w", nodeID, ErrNodeNotFound)
+	}
+
+	ancestors := make([]*Node[T], 0, max(n.Level(), 0))
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		ancestors = append(ancestors, p)
+	}
+
+	return ancestors, nil
+}
+
+// Descendants returns every transitive child of the node nodeID in breadth-first
+// order, excluding the node itself. A leaf yields an empty slice.
+//
+// Returns ErrNodeNotFound if nodeID is not in the segment.
+func (s *Segment[T]) Descendants(nodeID uint64) ([]*Node[T], error) {
+	n, exists := s.nodeMap[nodeID]
+	if !exists {
+		return nil, fmt.Errorf("descendants of node [%d]: %w", nodeID, ErrNodeNotFound)
+	}
+
+	descendants := make([]*Node[T], 0)
+	n.WalkLevels(func(level int, current *Node[T]) bool {
+		if level > 0 {
+			descendants = append(descendants, current)
+		}
+		return true
+	})
+
+	return descendants, nil
+}
+
+// Merge grafts the tree of other beneath the node parentID of the receiver. The
+// nodes reachable from the root of other are copied, attached under the parent
+// and re-leveled; other itself is left untouched. Nodes of other that are not
+// connected to its root (e.g. after Unlink) are not merged. Merging an empty
+// segment is a no-op.
+//
+// Returns an error and leaves the receiver unchanged if:
+//   - other is nil (ErrNil)
+//   - parentID is not in the segment or is detached (ErrParentNotInSegment)
+//   - A node ID of other already exists in the receiver (ErrNodeAlreadyInSegment)
+//   - The combined tree exceeds the receiver's capacity (ErrSegmentFull)
+//   - The grafted nodes would exceed the receiver's max depth (ErrSegmentMaxDepth)
+//   - The parent node has no room for another child (ErrMaxBreadth)
+func (s *Segment[T]) Merge(parentID uint64, other *Segment[T]) error {
+	if other == nil {
+		return fmt.Errorf("cannot merge: %w", ErrNil)
+	}
+
+	parent, exists := s.nodeMap[parentID]
+	if !exists {
+		return ErrParentNotInSegment
+	}
+
+	if parent.Level() < 0 {
+		return fmt.Errorf("parent node [%d] is detached: %w", parentID, ErrParentNotInSegment)
+	}
+
+	if other.root == nil {
+		return nil
+	}
+
+	graft := other.Clone().root
+	grafted := slices.Collect(graft.DescendantsIter())
+	for _, n := range grafted {
+		if _, exists := s.nodeMap[n.ID()]; exists {
+			return fmt.Errorf("node [%d]: %w", n.ID(), ErrNodeAlreadyInSegment)
+		}
+	}
+
+	switch {
+	case s.RemainingCapacity() < len(grafted):
+		return ErrSegmentFull
+	case parent.Level()+1+graft.Height() >= s.maxDepth:
+		return ErrSegmentMaxDepth
+	}
+
+	if err := parent.AttachChild(graft); err != nil {
+		return err
+	}
+
+	graft.WalkLevels(func(level int, n *Node[T]) bool {
+		n.setLevel(graft.Level() + level)
+		s.nodeMap[n.ID()] = n
+		s.addToLevelMap(n.Level(), n.ID())
+		return true
+	})
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package tree
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -834,6 +835,28 @@ func (s *SegmentTestSuite) TestSegment_SelectOne_NoMatch() {
 	s.Nil(node)
 }
 
+func (s *SegmentTestSuite) TestSegment_SelectByValue() {
+	seg, nodes := s.buildTestSegment()
+	twin := s.createAndInsert(seg, "child1", nodes["child2"].ID())
+
+	matched := seg.SelectByValue("child1")
+
+	s.ElementsMatch([]*Node[string]{nodes["child1"], twin}, matched)
+	s.Empty(seg.SelectByValue("nonexistent"))
+}
+
+func (s *SegmentTestSuite) TestSegment_SelectOneByValue() {
+	seg, nodes := s.buildTestSegment()
+
+	node, err := seg.SelectOneByValue("grandchild")
+	s.NoError(err)
+	s.Same(nodes["grandchild"], node)
+
+	node, err = seg.SelectOneByValue("nonexistent")
+	s.ErrorIs(err, ErrNoMatch)
+	s.Nil(node)
+}
+
 // ============================================================================
 // Integration Tests - Consistency Verification
 // ============================================================================
@@ -905,3 +928,381 @@ func (s *SegmentTestSuite) TestSegment_Link_MapsConsistency() {
 	s.False(child2.IsChildOf(root))
 	s.Equal(2, child2.Level())
 }
+
+func (s *SegmentTestSuite) TestSegment_Move_MapsConsistency() {
+	seg, nodes := s.buildTestSegment()
+	leaf := s.createAndInsert(seg, "leaf", nodes["grandchild"].ID())
+
+	// Move child1 (with grandchild and leaf) under child2
+	err := seg.Move(nodes["child1"].ID(), nodes["child2"].ID())
+	s.NoError(err)
+
+	// Verify nodeMap untouched
+	s.Equal(5, len(seg.nodeMap))
+
+	// Verify levelMap updated correctly
+	s.ElementsMatch([]uint64{nodes["root"].ID()}, seg.levelMap[0])
+	s.ElementsMatch([]uint64{nodes["child2"].ID()}, seg.levelMap[1])
+	s.ElementsMatch([]uint64{nodes["child1"].ID()}, seg.levelMap[2])
+	s.ElementsMatch([]uint64{nodes["grandchild"].ID()}, seg.levelMap[3])
+	s.ElementsMatch([]uint64{leaf.ID()}, seg.levelMap[4])
+
+	// Verify Node relations and levels
+	s.True(nodes["child1"].IsChildOf(nodes["child2"]))
+	s.False(nodes["root"].HasChild(nodes["child1"]))
+	s.Equal(2, nodes["child1"].Level())
+	s.Equal(3, nodes["grandchild"].Level())
+	s.Equal(4, leaf.Level())
+}
+
+func (s *SegmentTestSuite) TestSegment_Move_SameParent() {
+	seg, nodes := s.buildTestSegment()
+
+	err := seg.Move(nodes["child1"].ID(), nodes["root"].ID())
+	s.NoError(err)
+
+	s.True(nodes["child1"].IsChildOf(nodes["root"]))
+	s.Len(seg.levelMap[1], 2)
+	s.Len(seg.levelMap[2], 1)
+}
+
+func (s *SegmentTestSuite) TestSegment_Move_MaxDepthExceeded() {
+	seg := NewSegment[string]("test", s.nextID(), 5, 3)
+
+	root := s.createAndInsert(seg, "root", 0)
+	a := s.createAndInsert(seg, "a", root.ID())
+	b := s.createAndInsert(seg, "b", a.ID())
+	c := s.createAndInsert(seg, "c", root.ID())
+
+	// a has a child, so a under c would put b at level 3
+	err := seg.Move(a.ID(), c.ID())
+	s.ErrorIs(err, ErrSegmentMaxDepth)
+
+	// Segment is unchanged
+	s.True(a.IsChildOf(root))
+	s.True(b.IsChildOf(a))
+	s.Equal(1, a.Level())
+	s.Equal(2, b.Level())
+	s.ElementsMatch([]uint64{a.ID(), c.ID()}, seg.levelMap[1])
+	s.ElementsMatch([]uint64{b.ID()}, seg.levelMap[2])
+
+	// A leaf still fits
+	s.NoError(seg.Move(b.ID(), c.ID()))
+	s.Equal(2, b.Level())
+}
+
+func (s *SegmentTestSuite) TestSegment_Move_Errors() {
+	seg, nodes := s.buildTestSegment()
+
+	s.ErrorIs(seg.Move(nodes["child1"].ID(), 999999), ErrNodesNotInSegment)
+	s.ErrorIs(seg.Move(999999, nodes["root"].ID()), ErrNodesNotInSegment)
+	s.ErrorIs(seg.Move(nodes["root"].ID(), nodes["child2"].ID()), ErrHierarchyModel)
+	s.ErrorIs(seg.Move(nodes["child1"].ID(), nodes["grandchild"].ID()), ErrHierarchyModel)
+	s.ErrorIs(seg.Move(nodes["child1"].ID(), nodes["child1"].ID()), ErrHierarchyModel)
+
+	s.NoError(seg.Unlink(nodes["root"].ID(), nodes["child2"].ID()))
+	s.ErrorIs(seg.Move(nodes["grandchild"].ID(), nodes["child2"].ID()), ErrParentNotInSegment)
+
+	s.True(nodes["grandchild"].IsChildOf(nodes["child1"]))
+	s.True(nodes["child1"].IsChildOf(nodes["root"]))
+}
+
+func (s *SegmentTestSuite) TestSegment_Move_MaxBreadth() {
+	seg := NewSegment[string]("test", s.nextID(), 5, 5)
+
+	root := s.createAndInsert(seg, "root", 0)
+	full, err := NewNode[string](s.nextID(), 1, ValueOpt("full"))
+	s.Require().NoError(err)
+	s.Require().NoError(seg.Insert(full, root.ID()))
+	s.createAndInsert(seg, "occupant", full.ID())
+	mover := s.createAndInsert(seg, "mover", root.ID())
+
+	s.ErrorIs(seg.Move(mover.ID(), full.ID()), ErrMaxBreadth)
+	s.True(mover.IsChildOf(root))
+	s.Len(seg.levelMap[1], 2)
+}
+
+func (s *SegmentTestSuite) TestSegment_ToAdjacency() {
+	seg, nodes := s.buildTestSegment()
+
+	adjacency, rootID := seg.ToAdjacency()
+	s.Equal(nodes["root"].ID(), rootID)
+
+	children := []uint64{nodes["child1"].ID(), nodes["child2"].ID()}
+	slices.Sort(children)
+	s.Equal(map[uint64][]uint64{
+		nodes["root"].ID():       children,
+		nodes["child1"].ID():     {nodes["grandchild"].ID()},
+		nodes["child2"].ID():     {},
+		nodes["grandchild"].ID(): {},
+	}, adjacency)
+}
+
+func (s *SegmentTestSuite) TestSegment_ToAdjacency_AfterUnlink() {
+	seg, nodes := s.buildTestSegment()
+	s.Require().NoError(seg.Unlink(nodes["child1"].ID(), nodes["grandchild"].ID()))
+
+	adjacency, _ := seg.ToAdjacency()
+	s.Empty(adjacency[nodes["child1"].ID()])
+	s.Contains(adjacency, nodes["grandchild"].ID())
+}
+
+func (s *SegmentTestSuite) TestSegment_ToAdjacency_Empty() {
+	seg := NewSegment[string]("test", s.nextID(), 5, 5)
+
+	adjacency, rootID := seg.ToAdjacency()
+	s.Empty(adjacency)
+	s.Zero(rootID)
+}
+
+func (s *SegmentTestSuite) TestSegment_Clone_MapsConsistency() {
+	seg, nodes := s.buildTestSegment()
+
+	clone := seg.Clone()
+	s.Equal(seg.Alias(), clone.Alias())
+	s.Equal(seg.ID(), clone.ID())
+	s.Equal(seg.Capacity(), clone.Capacity())
+	s.Equal(seg.maxDepth, clone.maxDepth)
+	s.Equal(seg.maxBreadth, clone.maxBreadth)
+	s.Equal(seg.levelMap, clone.levelMap)
+
+	adjacency, rootID := seg.ToAdjacency()
+	cloneAdjacency, cloneRootID := clone.ToAdjacency()
+	s.Equal(adjacency, cloneAdjacency)
+	s.Equal(rootID, cloneRootID)
+
+	// Verify every node is a fresh copy with rebuilt relations
+	s.Equal(len(seg.nodeMap), len(clone.nodeMap))
+	for id, original := range seg.nodeMap {
+		copied := clone.nodeMap[id]
+		s.NotSame(original, copied)
+		s.Equal(original.Val(), copied.Val())
+		s.Equal(original.Level(), copied.Level())
+		s.Equal(original.IsRoot(), copied.IsRoot())
+		if original.HasParent() {
+			s.Same(clone.nodeMap[original.Parent().ID()], copied.Parent())
+		}
+	}
+
+	root, ok := clone.Root()
+	s.True(ok)
+	s.NotSame(nodes["root"], root)
+	s.True(root.IsRoot())
+}
+
+func (s *SegmentTestSuite) TestSegment_Clone_Independent() {
+	seg, nodes := s.buildTestSegment()
+	clone := seg.Clone()
+
+	s.Require().NoError(clone.Unlink(nodes["child1"].ID(), nodes["grandchild"].ID()))
+	s.Require().NoError(clone.RemoveCascade(nodes["child2"].ID()))
+	s.Require().NoError(clone.Insert(s.mustNode("extra"), nodes["root"].ID()))
+
+	// original is untouched
+	s.Equal(4, seg.Length())
+	s.Len(seg.levelMap[1], 2)
+	s.Len(seg.levelMap[2], 1)
+	s.True(nodes["grandchild"].IsChildOf(nodes["child1"]))
+	s.True(nodes["child2"].IsChildOf(nodes["root"]))
+	s.Equal(2, nodes["root"].Breadth())
+
+	s.Equal(4, clone.Length())
+	s.Len(clone.levelMap[1], 2)
+}
+
+func (s *SegmentTestSuite) TestSegment_Clone_Empty() {
+	seg := NewSegment[string]("test", s.nextID(), 5, 5)
+
+	clone := seg.Clone()
+	_, ok := clone.Root()
+	s.False(ok)
+	s.Zero(clone.Length())
+	s.Require().NoError(clone.Insert(s.mustNode("root"), 0))
+	s.Zero(seg.Length())
+}
+
+func (s *SegmentTestSuite) mustNode(value string) *Node[string] {
+	n, err := NewNode[string](s.nextID(), 5, ValueOpt(value))
+	s.Require().NoError(err)
+	return n
+}
+
+func (s *SegmentTestSuite) TestSegment_Path() {
+	seg, nodes := s.buildTestSegment()
+
+	cases := []struct {
+		from, to string
+		want     []string
+	}{
+		{"grandchild", "child2", []string{"grandchild", "child1", "root", "child2"}},
+		{"child2", "grandchild", []string{"child2", "root", "child1", "grandchild"}},
+		{"root", "grandchild", []string{"root", "child1", "grandchild"}},
+		{"grandchild", "root", []string{"grandchild", "child1", "root"}},
+		{"child1", "child1", []string{"child1"}},
+	}
+	for _, c := range cases {
+		path, err := seg.Path(nodes[c.from].ID(), nodes[c.to].ID())
+		s.Require().NoError(err, "%s -> %s", c.from, c.to)
+
+		values := make([]string, len(path))
+		for i, n := range path {
+			values[i] = n.Val()
+		}
+		s.Equal(c.want, values, "%s -> %s", c.from, c.to)
+	}
+}
+
+func (s *SegmentTestSuite) TestSegment_Path_Errors() {
+	seg, nodes := s.buildTestSegment()
+
+	_, err := seg.Path(nodes["root"].ID(), 999_999)
+	s.ErrorIs(err, ErrNodeNotFound)
+
+	_, err = seg.Path(999_999, nodes["root"].ID())
+	s.ErrorIs(err, ErrNodeNotFound)
+
+	s.Require().NoError(seg.Unlink(nodes["child1"].ID(), nodes["grandchild"].ID()))
+	_, err = seg.Path(nodes["grandchild"].ID(), nodes["child2"].ID())
+	s.ErrorIs(err, ErrNoMatch)
+}
+
+func (s *SegmentTestSuite) TestSegment_Ancestors() {
+	seg, nodes := s.buildTestSegment()
+	leaf := s.createAndInsert(seg, "leaf", nodes["grandchild"].ID())
+
+	vals := func(ns []*Node[string]) []string {
+		values := make([]string, len(ns))
+		for i, n := range ns {
+			values[i] = n.Val()
+		}
+		return values
+	}
+
+	ancestors, err := seg.Ancestors(leaf.ID())
+	s.Require().NoError(err)
+	s.Equal([]string{"grandchild", "child1", "root"}, vals(ancestors))
+
+	ancestors, err = seg.Ancestors(nodes["child2"].ID())
+	s.Require().NoError(err)
+	s.Equal([]string{"root"}, vals(ancestors))
+
+	ancestors, err = seg.Ancestors(nodes["root"].ID())
+	s.Require().NoError(err)
+	s.NotNil(ancestors)
+	s.Empty(ancestors)
+
+	_, err = seg.Ancestors(999_999)
+	s.ErrorIs(err, ErrNodeNotFound)
+}
+
+func (s *SegmentTestSuite) TestSegment_Descendants() {
+	seg, nodes := s.buildTestSegment()
+	leaf := s.createAndInsert(seg, "leaf", nodes["child2"].ID())
+
+	descendants, err := seg.Descendants(nodes["root"].ID())
+	s.Require().NoError(err)
+	s.Require().Len(descendants, 4)
+	s.ElementsMatch([]*Node[string]{nodes["child1"], nodes["child2"]}, descendants[:2])
+	s.ElementsMatch([]*Node[string]{nodes["grandchild"], leaf}, descendants[2:])
+
+	descendants, err = seg.Descendants(nodes["child1"].ID())
+	s.Require().NoError(err)
+	s.Equal([]*Node[string]{nodes["grandchild"]}, descendants)
+
+	descendants, err = seg.Descendants(nodes["grandchild"].ID())
+	s.Require().NoError(err)
+	s.NotNil(descendants)
+	s.Empty(descendants)
+
+	_, err = seg.Descendants(999_999)
+	s.ErrorIs(err, ErrNodeNotFound)
+}
+
+func (s *SegmentTestSuite) TestSegment_Merge() {
+	seg, nodes := s.buildTestSegment()
+
+	other := NewSegment[string]("other", s.nextID(), 5, 5)
+	branch := s.createAndInsert(other, "branch", 0)
+	leaf1 := s.createAndInsert(other, "leaf1", branch.ID())
+	leaf2 := s.createAndInsert(other, "leaf2", branch.ID())
+
+	s.Require().NoError(seg.Merge(nodes["child2"].ID(), other))
+	s.Equal(7, seg.Length())
+
+	grafted, err := seg.NodeByID(branch.ID())
+	s.Require().NoError(err)
+	s.NotSame(branch, grafted)
+	s.True(grafted.IsChildOf(nodes["child2"]))
+	s.True(grafted.IsAttached())
+	s.Equal(2, grafted.Level())
+
+	for _, id := range []uint64{leaf1.ID(), leaf2.ID()} {
+		leaf, err := seg.NodeByID(id)
+		s.Require().NoError(err)
+		s.Equal(3, leaf.Level())
+		s.True(leaf.IsChildOf(grafted))
+	}
+
+	// Verify levelMap
+	s.Len(seg.levelMap[0], 1)
+	s.Len(seg.levelMap[1], 2)
+	s.Len(seg.levelMap[2], 2)
+	s.Len(seg.levelMap[3], 2)
+
+	// other is untouched
+	s.Equal(3, other.Length())
+	s.True(branch.IsRoot())
+	s.True(leaf1.IsChildOf(branch))
+	s.Equal(1, leaf1.Level())
+}
+
+func (s *SegmentTestSuite) TestSegment_Merge_Empty() {
+	seg, nodes := s.buildTestSegment()
+
+	s.Require().NoError(seg.Merge(nodes["root"].ID(), NewSegment[string]("other", s.nextID(), 5, 5)))
+	s.Equal(4, seg.Length())
+}
+
+func (s *SegmentTestSuite) TestSegment_Merge_Errors() {
+	seg, nodes := s.buildTestSegment()
+	adjacency, _ := seg.ToAdjacency()
+
+	other := NewSegment[string]("other", s.nextID(), 5, 5)
+	branch := s.createAndInsert(other, "branch", 0)
+	s.createAndInsert(other, "leaf", branch.ID())
+
+	s.ErrorIs(seg.Merge(nodes["root"].ID(), nil), ErrNil)
+	s.ErrorIs(seg.Merge(999_999, other), ErrParentNotInSegment)
+
+	// depth: grafted leaf would land on level 5 with max depth 5
+	deep := s.createAndInsert(seg, "deep", nodes["grandchild"].ID())
+	s.ErrorIs(seg.Merge(deep.ID(), other), ErrSegmentMaxDepth)
+	s.Require().NoError(seg.RemoveCascade(deep.ID()))
+
+	// capacity
+	small := NewSegment[string]("small", s.nextID(), 1, 3)
+	s.Require().NoError(small.Insert(s.mustNode("root"), 0))
+	smallRoot, _ := small.Root()
+	s.Require().NoError(small.Insert(s.mustNode("child"), smallRoot.ID()))
+	s.ErrorIs(small.Merge(smallRoot.ID(), other), ErrSegmentFull)
+	s.Equal(2, small.Length())
+
+	// duplicate IDs
+	dup := NewSegment[string]("dup", s.nextID(), 5, 5)
+	dupRoot, err := NewNode[string](nodes["child1"].ID(), 5, ValueOpt("dup"))
+	s.Require().NoError(err)
+	s.Require().NoError(dup.Insert(dupRoot, 0))
+	s.ErrorIs(seg.Merge(nodes["root"].ID(), dup), ErrNodeAlreadyInSegment)
+
+	// receiver unchanged after every failure
+	after, _ := seg.ToAdjacency()
+	s.Equal(adjacency, after)
+	s.Equal(4, seg.Length())
+	s.Len(seg.levelMap[2], 1)
+
+	// detached parent
+	s.Require().NoError(seg.Unlink(nodes["root"].ID(), nodes["child2"].ID()))
+	s.ErrorIs(seg.Merge(nodes["child2"].ID(), other), ErrParentNotInSegment)
+	s.Equal(4, seg.Length())
+	s.False(nodes["child2"].HasChildren())
+}
@@ -0,0 +1,212 @@
+package tree
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+// skipListMaxLevel caps the number of forward pointers per node. With a
+// promotion probability of 1/2 it comfortably covers 2^32 entries.
+const skipListMaxLevel = 32
+
+type (
+	// skipListNode represents a node in the skip list. next[i] is the
+	// following node on level i.
+	skipListNode[K cmp.Ordered, V any] struct {
+		entry BTreeEntry[K, V]
+		next  []*skipListNode[K, V]
+	}
+
+	// SkipList is a probabilistic ordered map that stores key-value pairs in
+	// sorted key order.
+	//
+	// Entries live in a sorted linked list on level 0, and each node is
+	// promoted to every higher level with probability 1/2. Searches start on
+	// the highest level and drop down whenever the next key would overshoot,
+	// so search, insert and delete take O(log n) expected time without any
+	// rebalancing. Updates only touch the forward pointers around a single
+	// node, which keeps the structure simple compared to BTree or RBTree.
+	//
+	// Thread Safety:
+	// SkipList is not thread-safe. Concurrent access requires external synchronization.
+	SkipList[K cmp.Ordered, V any] struct {
+		head   *skipListNode[K, V]
+		level  int
+		length int
+		rng    *rand.Rand
+	}
+)
+
+// NewSkipList creates a new empty skip list.
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head: &skipListNode[K, V]{next: make([]*skipListNode[K, V], skipListMaxLevel)},
+		rng:  rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+}
+
+// Len returns the number of entries in the skip list.
+func (sl *SkipList[K, V]) Len() int {
+	return sl.length
+}
+
+// IsEmpty returns true if the skip list contains no entries.
+func (sl *SkipList[K, V]) IsEmpty() bool {
+	return sl.length == 0
+}
+
+// Insert adds a key-value pair to the skip list.
+// If the key already exists, the value is updated.
+func (sl *SkipList[K, V]) Insert(key K, value V) {
+	var update [skipListMaxLevel]*skipListNode[K, V]
+	x := sl.predecessors(key, &update)
+
+	if next := x.next[0]; next != nil && next.entry.Key == key {
+		next.entry.Value = value
+		return
+	}
+
+	level := sl.randomLevel()
+	for i := sl.level; i < level; i++ {
+		update[i] = sl.head
+	}
+	sl.level = max(sl.level, level)
+
+	n := &skipListNode[K, V]{
+		entry: BTreeEntry[K, V]{Key: key, Value: value},
+		next:  make([]*skipListNode[K, V], level),
+	}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+
+	sl.length++
+}
+
+// Search finds the value associated with the given key.
+// Returns the value and true if found, zero value and false otherwise.
+func (sl *SkipList[K, V]) Search(key K) (V, bool) {
+	if n := sl.lowerBound(key); n != nil && n.entry.Key == key {
+		return n.entry.Value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Contains returns true if the key exists in the skip list.
+func (sl *SkipList[K, V]) Contains(key K) bool {
+	n := sl.lowerBound(key)
+	return n != nil && n.entry.Key == key
+}
+
+// Delete removes a key from the skip list.
+// Returns true if the key was found and deleted, false otherwise.
+func (sl *SkipList[K, V]) Delete(key K) bool {
+	var update [skipListMaxLevel]*skipListNode[K, V]
+	x := sl.predecessors(key, &update).next[0]
+	if x == nil || x.entry.Key != key {
+		return false
+	}
+
+	for i := range x.next {
+		update[i].next[i] = x.next[i]
+	}
+	for sl.level > 0 && sl.head.next[sl.level-1] == nil {
+		sl.level--
+	}
+
+	sl.length--
+	return true
+}
+
+// Min returns the minimum key-value pair in the skip list.
+// Returns zero values and false if the skip list is empty.
+func (sl *SkipList[K, V]) Min() (key K, value V, found bool) {
+	n := sl.head.next[0]
+	if n == nil {
+		return key, value, false
+	}
+	return n.entry.Key, n.entry.Value, true
+}
+
+// Max returns the maximum key-value pair in the skip list.
+// Returns zero values and false if the skip list is empty.
+func (sl *SkipList[K, V]) Max() (key K, value V, found bool) {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			x = x.next[i]
+		}
+	}
+	if x == sl.head {
+		return key, value, false
+	}
+	return x.entry.Key, x.entry.Value, true
+}
+
+// All returns an iterator over all entries in ascending key order.
+func (sl *SkipList[K, V]) All() iter.Seq[BTreeEntry[K, V]] {
+	return func(yield func(BTreeEntry[K, V]) bool) {
+		for n := sl.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.entry) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over all entries with keys in [from, to].
+// The entries are yielded in ascending key order.
+func (sl *SkipList[K, V]) Range(from, to K) iter.Seq[BTreeEntry[K, V]] {
+	return func(yield func(BTreeEntry[K, V]) bool) {
+		for n := sl.lowerBound(from); n != nil && n.entry.Key <= to; n = n.next[0] {
+			if !yield(n.entry) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all entries from the skip list.
+func (sl *SkipList[K, V]) Clear() {
+	clear(sl.head.next)
+	sl.level = 0
+	sl.length = 0
+}
+
+// predecessors descends from the top level and records in update the last
+// node before key on every level. It returns the level-0 predecessor.
+func (sl *SkipList[K, V]) predecessors(key K, update *[skipListMaxLevel]*skipListNode[K, V]) *skipListNode[K, V] {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].entry.Key < key {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	return x
+}
+
+// lowerBound returns the node with the smallest key >= key, or nil.
+func (sl *SkipList[K, V]) lowerBound(key K) *skipListNode[K, V] {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].entry.Key < key {
+			x = x.next[i]
+		}
+	}
+	return x.next[0]
+}
+
+// randomLevel draws the number of levels for a new node: 1 plus the number of
+// successful coin flips, capped at skipListMaxLevel.
+func (sl *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && sl.rng.Uint64()&1 == 1 {
+		level++
+	}
+	return level
+}
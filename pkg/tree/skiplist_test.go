@@ -0,0 +1,248 @@
+package tree
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// SkipListTestSuite tests skip list operations
+type SkipListTestSuite struct {
+	suite.Suite
+	list *SkipList[int, string]
+}
+
+func (s *SkipListTestSuite) SetupTest() {
+	s.list = NewSkipList[int, string]()
+	s.list.rng = rand.New(rand.NewPCG(9, 10))
+}
+
+// checkSkipListInvariants verifies that every level is sorted, that each
+// level is a subsequence of the one below it and that Len matches level 0.
+func (s *SkipListTestSuite) checkSkipListInvariants() {
+	below := make(map[*skipListNode[int, string]]bool)
+	for i := 0; i < skipListMaxLevel; i++ {
+		current := make(map[*skipListNode[int, string]]bool)
+		count := 0
+		for n := s.list.head.next[i]; n != nil; n = n.next[i] {
+			if n.next[i] != nil && n.entry.Key >= n.next[i].entry.Key {
+				s.FailNowf("unsorted level", "level %d out of order at key %d", i, n.entry.Key)
+			}
+			if i > 0 && !below[n] {
+				s.FailNowf("missing node", "node %d on level %d missing below", n.entry.Key, i)
+			}
+			current[n] = true
+			count++
+		}
+
+		if i == 0 {
+			s.Require().Equal(s.list.Len(), count)
+		}
+		if i >= s.list.level {
+			s.Require().Zero(count, "level %d above the list level", i)
+		}
+		below = current
+	}
+}
+
+func (s *SkipListTestSuite) keys() []int {
+	var keys []int
+	for e := range s.list.All() {
+		keys = append(keys, e.Key)
+	}
+	return keys
+}
+
+func (s *SkipListTestSuite) TestEmpty() {
+	s.Require().True(s.list.IsEmpty())
+	s.Require().Equal(0, s.list.Len())
+	s.Require().False(s.list.Delete(1))
+	s.Require().False(s.list.Contains(1))
+
+	_, found := s.list.Search(1)
+	s.Require().False(found)
+	_, _, found = s.list.Min()
+	s.Require().False(found)
+	_, _, found = s.list.Max()
+	s.Require().False(found)
+	s.Require().Empty(s.keys())
+}
+
+func (s *SkipListTestSuite) TestInsertAndSearch() {
+	s.list.Insert(20, "twenty")
+	s.list.Insert(10, "ten")
+	s.list.Insert(30, "thirty")
+	s.list.Insert(10, "TEN")
+
+	s.Require().Equal(3, s.list.Len())
+	v, found := s.list.Search(10)
+	s.Require().True(found)
+	s.Require().Equal("TEN", v, "inserting an existing key updates its value")
+	s.Require().True(s.list.Contains(30))
+	s.Require().False(s.list.Contains(25))
+
+	k, v, found := s.list.Min()
+	s.Require().True(found)
+	s.Require().Equal(10, k)
+	s.Require().Equal("TEN", v)
+
+	k, v, found = s.list.Max()
+	s.Require().True(found)
+	s.Require().Equal(30, k)
+	s.Require().Equal("thirty", v)
+
+	s.checkSkipListInvariants()
+}
+
+func (s *SkipListTestSuite) TestDelete() {
+	for _, k := range []int{50, 30, 70, 20, 40} {
+		s.list.Insert(k, "")
+	}
+
+	s.Require().True(s.list.Delete(30))
+	s.Require().False(s.list.Delete(30))
+	s.Require().False(s.list.Contains(30))
+	s.Require().Equal([]int{20, 40, 50, 70}, s.keys())
+	s.checkSkipListInvariants()
+
+	for _, k := range []int{20, 40, 50, 70} {
+		s.Require().True(s.list.Delete(k))
+	}
+	s.Require().True(s.list.IsEmpty())
+	s.Require().Equal(0, s.list.level)
+	s.checkSkipListInvariants()
+}
+
+func (s *SkipListTestSuite) TestRange() {
+	for i := 20; i >= 1; i-- {
+		s.list.Insert(i*5, "")
+	}
+
+	collect := func(from, to int) []int {
+		var keys []int
+		for e := range s.list.Range(from, to) {
+			keys = append(keys, e.Key)
+		}
+		return keys
+	}
+
+	s.Require().Equal([]int{25, 30, 35, 40}, collect(25, 40))
+	s.Require().Equal([]int{25, 30, 35}, collect(23, 38))
+	s.Require().Equal([]int{5}, collect(-10, 5))
+	s.Require().Equal([]int{100}, collect(100, 200))
+	s.Require().Empty(collect(41, 44))
+	s.Require().Empty(collect(40, 25))
+
+	var first []int
+	for e := range s.list.Range(0, 100) {
+		first = append(first, e.Key)
+		if len(first) == 3 {
+			break
+		}
+	}
+	s.Require().Equal([]int{5, 10, 15}, first)
+}
+
+func (s *SkipListTestSuite) TestLargeDataset() {
+	const n = 100_000
+	rng := rand.New(rand.NewPCG(11, 12))
+	for _, k := range rng.Perm(n) {
+		s.list.Insert(k, "value")
+	}
+
+	s.Require().Equal(n, s.list.Len())
+	s.checkSkipListInvariants()
+
+	keys := s.keys()
+	s.Require().Len(keys, n)
+	s.Require().True(slices.IsSorted(keys))
+
+	var ranged []int
+	for e := range s.list.Range(1000, 1999) {
+		ranged = append(ranged, e.Key)
+	}
+	s.Require().Equal(1000, len(ranged))
+	s.Require().True(slices.IsSorted(ranged))
+
+	// Each level keeps about half the nodes of the one below, so the list
+	// should be about log2(n) levels tall.
+	logN := math.Log2(n)
+	s.Require().LessOrEqual(float64(s.list.level), 2*logN)
+	s.Require().GreaterOrEqual(float64(s.list.level), logN/2)
+
+	// A search visits O(log n) nodes in expectation.
+	totalSteps := 0
+	for i := 0; i < n; i += 97 {
+		totalSteps += s.searchSteps(i)
+	}
+	avgSteps := float64(totalSteps) / float64((n+96)/97)
+	s.Require().LessOrEqual(avgSteps, 4*logN)
+
+	for i := 0; i < n; i += 2 {
+		s.Require().True(s.list.Delete(i))
+	}
+	s.Require().Equal(n/2, s.list.Len())
+	for i := 0; i < 100; i++ {
+		s.Require().Equal(i%2 == 1, s.list.Contains(i), "key %d", i)
+	}
+	s.checkSkipListInvariants()
+}
+
+// searchSteps counts the forward pointers followed while locating key.
+func (s *SkipListTestSuite) searchSteps(key int) int {
+	steps := 0
+	x := s.list.head
+	for i := s.list.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].entry.Key < key {
+			x = x.next[i]
+			steps++
+		}
+		steps++
+	}
+	return steps
+}
+
+func (s *SkipListTestSuite) TestRandomOperations() {
+	rng := rand.New(rand.NewPCG(13, 14))
+	present := make(map[int]bool)
+
+	for i := 0; i < 5000; i++ {
+		k := rng.IntN(1000)
+		if rng.IntN(2) == 0 {
+			s.Require().Equal(present[k], s.list.Delete(k))
+			delete(present, k)
+		} else {
+			s.list.Insert(k, "")
+			present[k] = true
+		}
+	}
+
+	s.checkSkipListInvariants()
+	expected := make([]int, 0, len(present))
+	for k := range present {
+		expected = append(expected, k)
+	}
+	slices.Sort(expected)
+	s.Require().Equal(expected, s.keys())
+}
+
+func (s *SkipListTestSuite) TestClear() {
+	for i := 0; i < 10; i++ {
+		s.list.Insert(i, "")
+	}
+
+	s.list.Clear()
+	s.Require().True(s.list.IsEmpty())
+	s.Require().Empty(s.keys())
+
+	s.list.Insert(1, "one")
+	s.Require().Equal([]int{1}, s.keys())
+	s.checkSkipListInvariants()
+}
+
+func TestSkipListTestSuite(t *testing.T) {
+	suite.Run(t, new(SkipListTestSuite))
+}
@@ -0,0 +1,103 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Map rebuilds the subtree rooted at root as a new tree of type U, applying fn to
+// every value. Parent/child links and max breadths carry over, new node IDs are
+// drawn from idGen in depth-first order and the returned node is a root (level 0)
+// regardless of the position of root in its original tree.
+//
+// Returns an error if:
+//   - root, fn or idGen is nil (ErrNil)
+//   - idGen repeats an ID among the children of one mapped node (ErrHierarchyModel)
+//   - Any node cannot be created or attached
+//
+// Example:
+//
+//	lengths, err := Map(root, func(v string) int { return len(v) }, idGen)
+func Map[T, U comparable](root *Node[T], fn func(T) U, idGen func() uint64) (*Node[U], error) {
+	switch {
+	case root == nil:
+		return nil, fmt.Errorf("map root: %w", ErrNil)
+	case fn == nil:
+		return nil, fmt.Errorf("map func: %w", ErrNil)
+	case idGen == nil:
+		return nil, fmt.Errorf("map id generator: %w", ErrNil)
+	}
+
+	mapped, err := NewNode[U](idGen(), root.maxBreadth, ValueOpt(fn(root.val)))
+	if err != nil {
+		return nil, err
+	}
+	mapped.asRoot()
+
+	type frame struct {
+		src *Node[T]
+		dst *Node[U]
+	}
+
+	stack := []frame{{src: root, dst: mapped}}
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, child := range f.src.sortedChildren() {
+			mappedChild, errChild := NewNode[U](idGen(), child.maxBreadth, ValueOpt(fn(child.val)))
+			if errChild != nil {
+				return nil, errChild
+			}
+			if f.dst.HasChild(mappedChild) {
+				return nil, errors.Join(ErrHierarchyModel, fmt.Errorf("duplicate child id [%d] under node [%d]", mappedChild.id, f.dst.id))
+			}
+			if errAttach := f.dst.AttachChild(mappedChild); errAttach != nil {
+				return nil, errAttach
+			}
+
+			stack = append(stack, frame{src: child, dst: mappedChild})
+		}
+	}
+
+	return mapped, nil
+}
+
+// Fold reduces the subtree rooted at root to a single value by threading the
+// accumulator through every node in post-order: each node is passed to fn after
+// all of its descendants, with children visited in ascending ID order. The
+// traversal uses an explicit stack, so deep hierarchies do not grow the call stack.
+//
+// A nil root or fn returns init unchanged.
+//
+// Example:
+//
+//	total := Fold(root, 0, func(sum int, n *Node[int]) int { return sum + n.Val() })
+func Fold[T comparable, A any](root *Node[T], init A, fn func(acc A, n *Node[T]) A) A {
+	if root == nil || fn == nil {
+		return init
+	}
+
+	type frame struct {
+		n        *Node[T]
+		children []*Node[T]
+		next     int
+	}
+
+	acc := init
+	stack := []*frame{{n: root, children: root.sortedChildren()}}
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		if top.next < len(top.children) {
+			child := top.children[top.next]
+			top.next++
+			stack = append(stack, &frame{n: child, children: child.sortedChildren()})
+			continue
+		}
+
+		stack = stack[:len(stack)-1]
+		acc = fn(acc, top.n)
+	}
+
+	return acc
+}
@@ -0,0 +1,173 @@
+package tree
+
+import (
+	"iter"
+	"slices"
+)
+
+type (
+	// trieNode represents a single byte position in the trie.
+	trieNode[V any] struct {
+		children map[byte]*trieNode[V]
+		value    V
+		terminal bool // terminal reports whether a key ends at this node
+	}
+
+	// Trie is a prefix tree mapping string keys to values.
+	//
+	// Keys are split into bytes, so lookups cost O(len(key)) independent of
+	// the number of stored keys, and all keys sharing a prefix live under a
+	// single subtree. Deleting a key prunes branches that no longer lead to
+	// any key, so memory tracks the live key set.
+	//
+	// Iteration yields keys in lexicographic byte order, which for UTF-8
+	// strings is also code point order.
+	//
+	// Thread Safety:
+	// Trie is not thread-safe. Concurrent access requires external synchronization.
+	Trie[V any] struct {
+		root *trieNode[V]
+		size int
+	}
+)
+
+// NewTrie creates a new empty Trie.
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{root: &trieNode[V]{}}
+}
+
+// Size returns the number of keys in the trie.
+func (t *Trie[V]) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the trie contains no keys.
+func (t *Trie[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Insert adds a key-value pair to the trie.
+// If the key already exists, the value is updated.
+func (t *Trie[V]) Insert(key string, value V) {
+	n := t.root
+	for i := 0; i < len(key); i++ {
+		child, ok := n.children[key[i]]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[byte]*trieNode[V])
+			}
+			child = &trieNode[V]{}
+			n.children[key[i]] = child
+		}
+		n = child
+	}
+
+	if !n.terminal {
+		n.terminal = true
+		t.size++
+	}
+	n.value = value
+}
+
+// Get returns the value stored under key.
+// Returns the value and true if found, zero value and false otherwise.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.terminal {
+		return n.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Delete removes key from the trie and prunes any branch left without keys.
+// Returns true if the key was found and deleted, false otherwise.
+func (t *Trie[V]) Delete(key string) bool {
+	path := make([]*trieNode[V], 0, len(key)+1)
+	n := t.root
+	path = append(path, n)
+
+	for i := 0; i < len(key); i++ {
+		child, ok := n.children[key[i]]
+		if !ok {
+			return false
+		}
+		n = child
+		path = append(path, n)
+	}
+
+	if !n.terminal {
+		return false
+	}
+
+	var zero V
+	n.terminal = false
+	n.value = zero
+	t.size--
+
+	// Walk back up, detaching nodes that no longer lead to any key.
+	for i := len(key); i > 0; i-- {
+		if n := path[i]; n.terminal || len(n.children) > 0 {
+			break
+		}
+		delete(path[i-1].children, key[i-1])
+	}
+
+	return true
+}
+
+// HasPrefix returns true if at least one key starts with prefix.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	n := t.find(prefix)
+	return n != nil && (n.terminal || len(n.children) > 0)
+}
+
+// WithPrefix returns an iterator over all key-value pairs whose key starts
+// with prefix, in lexicographic key order.
+func (t *Trie[V]) WithPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		start := t.find(prefix)
+		if start == nil {
+			return
+		}
+
+		type frame struct {
+			key  string
+			node *trieNode[V]
+		}
+		stack := []frame{{key: prefix, node: start}}
+
+		for len(stack) > 0 {
+			f := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if f.node.terminal && !yield(f.key, f.node.value) {
+				return
+			}
+
+			// Push in descending byte order so the smallest is visited first.
+			edges := make([]byte, 0, len(f.node.children))
+			for b := range f.node.children {
+				edges = append(edges, b)
+			}
+			slices.Sort(edges)
+			for i := len(edges) - 1; i >= 0; i-- {
+				stack = append(stack, frame{key: f.key + string([]byte{edges[i]}), node: f.node.children[edges[i]]})
+			}
+		}
+	}
+}
+
+// All returns an iterator over all key-value pairs in lexicographic key order.
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return t.WithPrefix("")
+}
+
+// find returns the node reached by following key, or nil.
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := t.root
+	for i := 0; i < len(key) && n != nil; i++ {
+		n = n.children[key[i]]
+	}
+	return n
+}
@@ -0,0 +1,172 @@
+package tree
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TrieTestSuite tests prefix tree operations
+type TrieTestSuite struct {
+	suite.Suite
+	trie *Trie[int]
+}
+
+func (s *TrieTestSuite) SetupTest() {
+	s.trie = NewTrie[int]()
+}
+
+func (s *TrieTestSuite) load(keys ...string) {
+	for i, k := range keys {
+		s.trie.Insert(k, i+1)
+	}
+}
+
+func (s *TrieTestSuite) collect(prefix string) []string {
+	var keys []string
+	for k := range s.trie.WithPrefix(prefix) {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// nodeCount counts every node in the trie, including the root.
+func (s *TrieTestSuite) nodeCount() int {
+	count := 0
+	stack := []*trieNode[int]{s.trie.root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		count++
+		stack = slices.AppendSeq(stack, maps.Values(n.children))
+	}
+	return count
+}
+
+func (s *TrieTestSuite) TestInsertAndGet() {
+	s.load("topic", "top", "topics", "tea")
+
+	s.Require().Equal(4, s.trie.Size())
+	for i, k := range []string{"topic", "top", "topics", "tea"} {
+		v, ok := s.trie.Get(k)
+		s.Require().True(ok, k)
+		s.Require().Equal(i+1, v, k)
+	}
+
+	for _, k := range []string{"t", "to", "topi", "topicss", "x", ""} {
+		_, ok := s.trie.Get(k)
+		s.Require().False(ok, k)
+	}
+
+	s.trie.Insert("top", 42)
+	v, _ := s.trie.Get("top")
+	s.Require().Equal(42, v, "inserting an existing key updates its value")
+	s.Require().Equal(4, s.trie.Size())
+}
+
+func (s *TrieTestSuite) TestEmptyKey() {
+	s.trie.Insert("", 7)
+
+	v, ok := s.trie.Get("")
+	s.Require().True(ok)
+	s.Require().Equal(7, v)
+	s.Require().True(s.trie.HasPrefix(""))
+
+	s.Require().True(s.trie.Delete(""))
+	s.Require().True(s.trie.IsEmpty())
+	s.Require().False(s.trie.HasPrefix(""))
+}
+
+func (s *TrieTestSuite) TestHasPrefix() {
+	s.load("orders.created", "orders.shipped", "users")
+
+	s.Require().True(s.trie.HasPrefix(""))
+	s.Require().True(s.trie.HasPrefix("o"))
+	s.Require().True(s.trie.HasPrefix("orders."))
+	s.Require().True(s.trie.HasPrefix("orders.created"))
+	s.Require().False(s.trie.HasPrefix("orders.created.v2"))
+	s.Require().False(s.trie.HasPrefix("payments"))
+}
+
+func (s *TrieTestSuite) TestWithPrefix() {
+	s.load("orders.shipped", "users", "orders.created", "orders", "ordinal")
+
+	s.Require().Equal([]string{"orders", "orders.created", "orders.shipped"}, s.collect("orders"))
+	s.Require().Equal([]string{"orders.created", "orders.shipped"}, s.collect("orders."))
+	s.Require().Equal([]string{"orders", "orders.created", "orders.shipped", "ordinal"}, s.collect("ord"))
+	s.Require().Empty(s.collect("payments"))
+
+	var all []string
+	for k, v := range s.trie.All() {
+		all = append(all, k)
+		got, _ := s.trie.Get(k)
+		s.Require().Equal(got, v)
+	}
+	s.Require().True(slices.IsSorted(all))
+	s.Require().Len(all, 5)
+
+	var first []string
+	for k := range s.trie.WithPrefix("") {
+		first = append(first, k)
+		if len(first) == 2 {
+			break
+		}
+	}
+	s.Require().Equal([]string{"orders", "orders.created"}, first)
+}
+
+func (s *TrieTestSuite) TestDelete() {
+	s.load("top", "topic", "tea")
+
+	s.Require().False(s.trie.Delete("to"), "prefix of a key is not a key")
+	s.Require().False(s.trie.Delete("toast"))
+	s.Require().Equal(3, s.trie.Size())
+
+	s.Require().True(s.trie.Delete("top"))
+	s.Require().False(s.trie.Delete("top"))
+	_, ok := s.trie.Get("top")
+	s.Require().False(ok)
+	s.Require().True(s.trie.HasPrefix("top"), "topic is still present")
+
+	v, ok := s.trie.Get("topic")
+	s.Require().True(ok)
+	s.Require().Equal(2, v)
+	s.Require().Equal(2, s.trie.Size())
+}
+
+func (s *TrieTestSuite) TestDeletePrunesBranches() {
+	s.load("tea")
+	base := s.nodeCount()
+
+	s.load("topic", "topics")
+	s.Require().Greater(s.nodeCount(), base)
+
+	s.Require().True(s.trie.Delete("topics"))
+	s.Require().True(s.trie.HasPrefix("topic"))
+	s.Require().False(s.trie.HasPrefix("topics"))
+
+	s.Require().True(s.trie.Delete("topic"))
+	s.Require().False(s.trie.HasPrefix("to"))
+	s.Require().Equal(base, s.nodeCount(), "branches without keys must be pruned")
+
+	s.Require().True(s.trie.Delete("tea"))
+	s.Require().Equal(1, s.nodeCount())
+	s.Require().True(s.trie.IsEmpty())
+}
+
+func (s *TrieTestSuite) TestUnicodeKeys() {
+	s.load("héllo", "hello", "日本", "日本語")
+
+	s.Require().Equal([]string{"日本", "日本語"}, s.collect("日"))
+	s.Require().Equal([]string{"hello", "héllo"}, s.collect("h"))
+
+	v, ok := s.trie.Get("日本語")
+	s.Require().True(ok)
+	s.Require().Equal(4, v)
+}
+
+func TestTrieTestSuite(t *testing.T) {
+	suite.Run(t, new(TrieTestSuite))
+}
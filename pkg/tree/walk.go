@@ -0,0 +1,175 @@
+package tree
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// DescendantsIter returns an iterator over the subtree rooted at n in depth-first
+// pre-order: the receiver is yielded first, then each child subtree in ascending
+// child ID order. The traversal uses an explicit stack, so arbitrarily deep
+// hierarchies do not grow the call stack.
+func (n *Node[T]) DescendantsIter() iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		// Nodes are stacked by pointer: IDs are only unique among siblings, so
+		// cousins may share one.
+		stack := []*Node[T]{n}
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(current) {
+				return
+			}
+
+			children := current.sortedChildren()
+			for i := len(children) - 1; i >= 0; i-- {
+				stack = append(stack, children[i])
+			}
+		}
+	}
+}
+
+// Walk traverses the subtree rooted at n depth-first, visiting the receiver
+// first. The walk stops as soon as visit returns false. A nil visit is a no-op.
+func (n *Node[T]) Walk(visit func(*Node[T]) bool) {
+	if visit == nil {
+		return
+	}
+
+	for current := range n.DescendantsIter() {
+		if !visit(current) {
+			return
+		}
+	}
+}
+
+// WalkCtx is Walk with cancellation: ctx is checked before each node is visited
+// and the walk stops with ctx.Err() as soon as it is done, including when ctx is
+// already done before the first node. Returns nil once every node has been
+// visited or visit returns false. A nil visit is a no-op.
+func (n *Node[T]) WalkCtx(ctx context.Context, visit func(*Node[T]) bool) error {
+	if visit == nil {
+		return nil
+	}
+
+	for current := range n.DescendantsIter() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !visit(current) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// WalkLevels traverses the subtree rooted at n breadth-first, passing each node
+// together with its level relative to n (the receiver is level 0). Nodes on the
+// same level are visited in ascending ID order within each parent. The walk stops
+// as soon as visit returns false. A nil visit is a no-op.
+func (n *Node[T]) WalkLevels(visit func(level int, n *Node[T]) bool) {
+	if visit == nil {
+		return
+	}
+
+	queue := []*Node[T]{n}
+	for level := 0; len(queue) > 0; level++ {
+		width := len(queue)
+		for _, current := range queue[:width] {
+			if !visit(level, current) {
+				return
+			}
+
+			queue = append(queue, current.sortedChildren()...)
+		}
+		queue = queue[width:]
+	}
+}
+
+// FindByID searches the subtree rooted at n, including n itself, for the node with
+// the given ID. Children are keyed by a hash of the parent and child IDs, so the
+// search compares each node's own ID rather than the child map keys.
+//
+// Returns ErrNodeNotFound if no node in the subtree has the ID.
+func (n *Node[T]) FindByID(id uint64) (*Node[T], error) {
+	for current := range n.DescendantsIter() {
+		if current.ID() == id {
+			return current, nil
+		}
+	}
+
+	return nil, fmt.Errorf("node [%d]: %w", id, ErrNodeNotFound)
+}
+
+// Height returns the number of edges on the longest downward path from n to a
+// leaf of its subtree. A leaf has height 0. The subtree is walked level by
+// level with an explicit frontier.
+func (n *Node[T]) Height() int {
+	height := -1
+	for level := []*Node[T]{n}; len(level) > 0; height++ {
+		var next []*Node[T]
+		for _, current := range level {
+			for _, child := range current.children {
+				next = append(next, child)
+			}
+		}
+		level = next
+	}
+
+	return height
+}
+
+// Depth returns the number of edges between n and its topmost ancestor. Unlike
+// Level it follows the Parent chain, so it stays correct even if levels are stale.
+// A node without a parent has depth 0.
+func (n *Node[T]) Depth() int {
+	var depth int
+	for p := n.parent; p != nil; p = p.parent {
+		depth++
+	}
+
+	return depth
+}
+
+// Size returns the number of nodes in the subtree rooted at n, including n
+// itself. A leaf has size 1.
+func (n *Node[T]) Size() int {
+	var size int
+	for range n.DescendantsIter() {
+		size++
+	}
+
+	return size
+}
+
+// CountFunc returns the number of nodes in the subtree rooted at n, including n
+// itself, for which pred returns true. A nil pred counts nothing.
+func (n *Node[T]) CountFunc(pred NodeSuccessorFunc[T]) int {
+	if pred == nil {
+		return 0
+	}
+
+	var count int
+	for current := range n.DescendantsIter() {
+		if pred(current) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Leaves returns every node without children in the subtree rooted at n, in the
+// depth-first order of DescendantsIter. A childless receiver is its own only leaf.
+func (n *Node[T]) Leaves() []*Node[T] {
+	var leaves []*Node[T]
+	for current := range n.DescendantsIter() {
+		if !current.HasChildren() {
+			leaves = append(leaves, current)
+		}
+	}
+
+	return leaves
+}
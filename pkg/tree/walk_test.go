@@ -0,0 +1,316 @@
+package tree
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WalkTestSuite struct {
+	suite.Suite
+}
+
+func TestWalkTestSuite(t *testing.T) {
+	suite.Run(t, new(WalkTestSuite))
+}
+
+func (s *WalkTestSuite) newNode(id uint64, val string, parent *Node[string]) *Node[string] {
+	opts := []NodeOption[string]{ValueOpt(val)}
+	if parent != nil {
+		opts = append(opts, ParentOpt(parent))
+	} else {
+		opts = append(opts, LevelOpt[string](0))
+	}
+	n, err := NewNode[string](id, 3, opts...)
+	s.Require().NoError(err)
+	return n
+}
+
+func (s *WalkTestSuite) buildTree() *Node[string] {
+	ceo := s.newNode(1, "CEO", nil)
+	cto := s.newNode(2, "CTO", ceo)
+	cfo := s.newNode(3, "CFO", ceo)
+	s.newNode(4, "PSA", cto)
+	s.newNode(5, "PSE", cto)
+	s.newNode(6, "DM", cto)
+	s.newNode(7, "SEM", cfo)
+	s.newNode(8, "PA", cfo)
+	return ceo
+}
+
+// buildRepeatedIDTree builds a tree whose node IDs are unique among siblings
+// but repeat across branches:
+//
+//	root(0) -> a(1) -> a2(2), a5(5)
+//	        -> b(2) -> b5(5)
+func (s *WalkTestSuite) buildRepeatedIDTree() *Node[string] {
+	root := s.newNode(0, "root", nil)
+	a := s.newNode(1, "a", root)
+	b := s.newNode(2, "b", root)
+	s.newNode(2, "a2", a)
+	s.newNode(5, "a5", a)
+	s.newNode(5, "b5", b)
+	return root
+}
+
+func (s *WalkTestSuite) TestDescendantsIter_PreOrder() {
+	root := s.buildTree()
+
+	var visited []string
+	for n := range root.DescendantsIter() {
+		visited = append(visited, n.Val())
+	}
+	s.Require().Equal([]string{"CEO", "CTO", "PSA", "PSE", "DM", "CFO", "SEM", "PA"}, visited)
+}
+
+func (s *WalkTestSuite) TestDescendantsIter_Subtree() {
+	root := s.buildTree()
+	cfo, err := root.SelectChildByID(3)
+	s.Require().NoError(err)
+
+	var visited []string
+	for n := range cfo.DescendantsIter() {
+		visited = append(visited, n.Val())
+	}
+	s.Require().Equal([]string{"CFO", "SEM", "PA"}, visited)
+}
+
+func (s *WalkTestSuite) TestWalk_StopsEarly() {
+	root := s.buildTree()
+
+	var visited []string
+	root.Walk(func(n *Node[string]) bool {
+		visited = append(visited, n.Val())
+		return n.Val() != "PSE"
+	})
+	s.Require().Equal([]string{"CEO", "CTO", "PSA", "PSE"}, visited)
+}
+
+func (s *WalkTestSuite) TestWalk_Leaf() {
+	leaf := s.newNode(1, "leaf", nil)
+
+	var count int
+	leaf.Walk(func(*Node[string]) bool {
+		count++
+		return true
+	})
+	s.Require().Equal(1, count)
+	s.Require().NotPanics(func() { leaf.Walk(nil) })
+}
+
+func (s *WalkTestSuite) TestWalk_DeepHierarchy() {
+	const depth = 100_000
+	root := s.newNode(1, "root", nil)
+	parent := root
+	for id := uint64(2); id <= depth; id++ {
+		parent = s.newNode(id, "n", parent)
+	}
+
+	var count int
+	root.Walk(func(*Node[string]) bool {
+		count++
+		return true
+	})
+	s.Require().Equal(depth, count)
+}
+
+func (s *WalkTestSuite) TestWalkCtx() {
+	root := s.buildTree()
+
+	var visited []string
+	err := root.WalkCtx(context.Background(), func(n *Node[string]) bool {
+		visited = append(visited, n.Val())
+		return true
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"CEO", "CTO", "PSA", "PSE", "DM", "CFO", "SEM", "PA"}, visited)
+
+	visited = nil
+	err = root.WalkCtx(context.Background(), func(n *Node[string]) bool {
+		visited = append(visited, n.Val())
+		return n.Val() != "CTO"
+	})
+	s.Require().NoError(err, "stopping from visit is not an error")
+	s.Require().Equal([]string{"CEO", "CTO"}, visited)
+
+	s.Require().NoError(root.WalkCtx(context.Background(), nil))
+}
+
+func (s *WalkTestSuite) TestWalkCtx_Cancelled() {
+	root := s.buildTree()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var count int
+	err := root.WalkCtx(ctx, func(*Node[string]) bool {
+		count++
+		return true
+	})
+	s.Require().ErrorIs(err, context.Canceled)
+	s.Require().Zero(count)
+
+	// Cancelling mid-walk stops before the next node.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	var visited []string
+	err = root.WalkCtx(ctx, func(n *Node[string]) bool {
+		visited = append(visited, n.Val())
+		if n.Val() == "PSE" {
+			cancel()
+		}
+		return true
+	})
+	s.Require().ErrorIs(err, context.Canceled)
+	s.Require().Equal([]string{"CEO", "CTO", "PSA", "PSE"}, visited)
+}
+
+func (s *WalkTestSuite) TestWalkCtx_Timeout() {
+	const depth = 100_000
+	root := s.newNode(1, "root", nil)
+	parent := root
+	for id := uint64(2); id <= depth; id++ {
+		parent = s.newNode(id, "n", parent)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	var count int
+	err := root.WalkCtx(ctx, func(*Node[string]) bool {
+		count++
+		time.Sleep(10 * time.Microsecond)
+		return true
+	})
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Require().Less(count, depth)
+}
+
+func (s *WalkTestSuite) TestWalkLevels() {
+	root := s.buildTree()
+
+	levels := make(map[int][]string)
+	var order []string
+	root.WalkLevels(func(level int, n *Node[string]) bool {
+		levels[level] = append(levels[level], n.Val())
+		order = append(order, n.Val())
+		return true
+	})
+	s.Require().Equal(map[int][]string{
+		0: {"CEO"},
+		1: {"CTO", "CFO"},
+		2: {"PSA", "PSE", "DM", "SEM", "PA"},
+	}, levels)
+	s.Require().Equal([]string{"CEO", "CTO", "CFO", "PSA", "PSE", "DM", "SEM", "PA"}, order)
+}
+
+func (s *WalkTestSuite) TestWalkLevels_RelativeToReceiver() {
+	root := s.buildTree()
+	cto, err := root.SelectChildByID(2)
+	s.Require().NoError(err)
+
+	levels := make(map[int][]string)
+	cto.WalkLevels(func(level int, n *Node[string]) bool {
+		levels[level] = append(levels[level], n.Val())
+		return true
+	})
+	s.Require().Equal(map[int][]string{0: {"CTO"}, 1: {"PSA", "PSE", "DM"}}, levels)
+}
+
+func (s *WalkTestSuite) TestWalkLevels_StopsEarly() {
+	root := s.buildTree()
+
+	var visited []string
+	root.WalkLevels(func(level int, n *Node[string]) bool {
+		visited = append(visited, n.Val())
+		return level < 1
+	})
+	s.Require().Equal([]string{"CEO", "CTO"}, visited)
+	s.Require().NotPanics(func() { root.WalkLevels(nil) })
+}
+
+func (s *WalkTestSuite) TestFindByID() {
+	root := s.buildTree()
+
+	for id, val := range map[uint64]string{1: "CEO", 3: "CFO", 6: "DM", 8: "PA"} {
+		found, err := root.FindByID(id)
+		s.Require().NoError(err, "id %d", id)
+		s.Require().Equal(val, found.Val())
+		s.Require().Equal(id, found.ID())
+	}
+
+	cto, err := root.SelectChildByID(2)
+	s.Require().NoError(err)
+
+	psa, err := cto.FindByID(4)
+	s.Require().NoError(err)
+	s.Require().Equal("PSA", psa.Val())
+
+	// the search is limited to the receiver's subtree
+	_, err = cto.FindByID(7)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+
+	_, err = root.FindByID(99)
+	s.Require().ErrorIs(err, ErrNodeNotFound)
+}
+
+func (s *WalkTestSuite) TestLeaves() {
+	root := s.buildTree()
+
+	values := func(nodes []*Node[string]) []string {
+		res := make([]string, len(nodes))
+		for i, n := range nodes {
+			res[i] = n.Val()
+		}
+		return res
+	}
+	s.Require().Equal([]string{"PSA", "PSE", "DM", "SEM", "PA"}, values(root.Leaves()))
+
+	cfo, err := root.FindByID(3)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"SEM", "PA"}, values(cfo.Leaves()))
+
+	pa, err := root.FindByID(8)
+	s.Require().NoError(err)
+	s.Require().Equal([]*Node[string]{pa}, pa.Leaves())
+}
+
+func (s *WalkTestSuite) TestDescendantsIter_RepeatedIDs() {
+	root := s.buildRepeatedIDTree()
+
+	var visited []string
+	for n := range root.DescendantsIter() {
+		visited = append(visited, n.Val())
+	}
+	s.Require().Equal([]string{"root", "a", "a2", "a5", "b", "b5"}, visited)
+	s.Require().Equal(6, root.Size())
+	s.Require().Len(root.Leaves(), 3)
+}
+
+func (s *WalkTestSuite) TestHeight_RepeatedIDs() {
+	root := s.newNode(0, "root", nil)
+	a := s.newNode(1, "a", root)
+	b := s.newNode(2, "b", root)
+	s.newNode(5, "a5", a)
+	b5 := s.newNode(5, "b5", b)
+	s.newNode(9, "b5-9", b5)
+
+	s.Require().Equal(3, root.Height())
+	s.Require().Equal(1, a.Height())
+	s.Require().Equal(2, b.Height())
+}
+
+func (s *WalkTestSuite) TestWalkLevels_RepeatedIDs() {
+	root := s.buildRepeatedIDTree()
+
+	levels := make(map[int][]string)
+	root.WalkLevels(func(level int, n *Node[string]) bool {
+		levels[level] = append(levels[level], n.Val())
+		return true
+	})
+	s.Require().Equal(map[int][]string{
+		0: {"root"},
+		1: {"a", "b"},
+		2: {"a2", "a5", "b5"},
+	}, levels)
+}